@@ -0,0 +1,227 @@
+package kongo
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// SeverityLow it's a finding that leaks information or deviates from good practices.
+	SeverityLow Severity = iota + 1
+
+	// SeverityMedium it's a finding that weakens the node security.
+	SeverityMedium
+
+	// SeverityHigh it's a finding that exposes sensitive data.
+	SeverityHigh
+
+	// SeverityCritical it's a finding that exposes the Admin API.
+	SeverityCritical
+)
+
+type (
+	// Severity it's the severity of an audit finding.
+	Severity int
+
+	// AuditRule it's a check evaluated against the node information.
+	AuditRule struct {
+		// The rule name, e.g. admin-listen-without-ssl.
+		Name string
+
+		// The severity of the findings reported by the rule.
+		Severity Severity
+
+		// Check returns one message per violation found on the node information.
+		Check func(info *NodeInfo) []string
+	}
+
+	// Finding it's a rule violation found on the node information.
+	Finding struct {
+		// The name of the violated rule.
+		Rule string
+
+		// The finding severity.
+		Severity Severity
+
+		// Description of the violation.
+		Message string
+	}
+
+	// Auditor evaluates the node information against a rule set.
+	Auditor struct {
+		// Rules evaluated by the auditor.
+		Rules []*AuditRule
+	}
+)
+
+// NewAuditor returns an auditor with the given rules, the default rules are used when none is given.
+func NewAuditor(rules ...*AuditRule) *Auditor {
+	if len(rules) == 0 {
+		rules = DefaultAuditRules()
+	}
+
+	return &Auditor{Rules: rules}
+}
+
+// DefaultAuditRules returns the default rule set.
+func DefaultAuditRules() []*AuditRule {
+	return []*AuditRule{
+		{
+			Name:     "admin-listen-without-ssl",
+			Severity: SeverityCritical,
+			Check: func(info *NodeInfo) []string {
+				messages := []string{}
+
+				for _, listener := range info.Configuration.AdminListeners {
+					if !listener.SSL && isAnyAddress(listener.Ip) {
+						messages = append(messages, fmt.Sprintf("Admin API listens on %s without SSL", listener.Listener))
+					}
+				}
+
+				return messages
+			},
+		},
+		{
+			Name:     "postgres-ssl-disabled",
+			Severity: SeverityHigh,
+			Check: func(info *NodeInfo) []string {
+				if info.Configuration.Database != "postgres" || info.Configuration.PostgresSSL {
+					return nil
+				}
+
+				return []string{"Postgres connection does not use SSL"}
+			},
+		},
+		{
+			Name:     "cassandra-ssl-disabled",
+			Severity: SeverityHigh,
+			Check: func(info *NodeInfo) []string {
+				if info.Configuration.Database != "cassandra" || info.Configuration.CassandraSSL {
+					return nil
+				}
+
+				return []string{"Cassandra connection does not use SSL"}
+			},
+		},
+		{
+			Name:     "database-ssl-not-verified",
+			Severity: SeverityMedium,
+			Check: func(info *NodeInfo) []string {
+				c := info.Configuration
+
+				switch {
+				case c.Database == "postgres" && c.PostgresSSL && !c.PostgresSSLVerify:
+					return []string{"Postgres SSL certificate is not verified"}
+				case c.Database == "cassandra" && c.CassandraSSL && !c.CassandraSSLVerify:
+					return []string{"Cassandra SSL certificate is not verified"}
+				}
+
+				return nil
+			},
+		},
+		{
+			Name:     "trusted-ips-open",
+			Severity: SeverityHigh,
+			Check: func(info *NodeInfo) []string {
+				messages := []string{}
+
+				for _, ip := range interfaceStrings(info.Configuration.TrustedIps) {
+					if ip == "0.0.0.0/0" || ip == "::/0" {
+						messages = append(messages, fmt.Sprintf("Trusted IPs allow any address with %s", ip))
+					}
+				}
+
+				return messages
+			},
+		},
+		{
+			Name:     "anonymous-reports-enabled",
+			Severity: SeverityLow,
+			Check: func(info *NodeInfo) []string {
+				if !info.Configuration.AnonymousReports {
+					return nil
+				}
+
+				return []string{"Anonymous reports are sent to Kong"}
+			},
+		},
+		{
+			Name:     "server-tokens-exposed",
+			Severity: SeverityLow,
+			Check: func(info *NodeInfo) []string {
+				if !info.Configuration.ServerTokens {
+					return nil
+				}
+
+				return []string{"Server tokens expose the Kong version"}
+			},
+		},
+	}
+}
+
+// Audit evaluates the node information against the rules and returns the findings.
+func (a *Auditor) Audit(info *NodeInfo) []*Finding {
+	findings := []*Finding{}
+
+	if info == nil || info.Configuration == nil {
+		return findings
+	}
+
+	for _, rule := range a.Rules {
+		for _, message := range rule.Check(info) {
+			findings = append(findings, &Finding{Rule: rule.Name, Severity: rule.Severity, Message: message})
+		}
+	}
+
+	return findings
+}
+
+// String returns the severity name.
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	case SeverityCritical:
+		return "critical"
+	}
+
+	return "unknown"
+}
+
+// isAnyAddress reports whether the ip binds every interface.
+func isAnyAddress(ip string) bool {
+	ip = strings.Trim(ip, "[]")
+
+	return ip == "0.0.0.0" || ip == "::"
+}
+
+// interfaceStrings returns the trimmed strings of a configuration value that can be a comma separated string
+// or a list.
+func interfaceStrings(value interface{}) []string {
+	items := []interface{}{}
+
+	switch v := value.(type) {
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			items = append(items, item)
+		}
+	case []interface{}:
+		items = v
+	default:
+		return nil
+	}
+
+	values := []string{}
+
+	for _, item := range items {
+		if s, ok := item.(string); ok && strings.TrimSpace(s) != "" {
+			values = append(values, strings.TrimSpace(s))
+		}
+	}
+
+	return values
+}
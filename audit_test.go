@@ -0,0 +1,109 @@
+package kongo
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type AuditTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *AuditTestSuite) rules(findings []*Finding) []string {
+	rules := []string{}
+
+	for _, finding := range findings {
+		rules = append(rules, finding.Rule)
+	}
+
+	return rules
+}
+
+func (s *AuditTestSuite) TestAuditFixture() {
+	findings := NewAuditor().Audit(s.nodeInfo())
+
+	s.assert.Equal(
+		[]string{"admin-listen-without-ssl", "postgres-ssl-disabled", "anonymous-reports-enabled", "server-tokens-exposed"},
+		s.rules(findings),
+	)
+	s.assert.Equal(SeverityCritical, findings[0].Severity)
+	s.assert.Equal("Admin API listens on 0.0.0.0:8001 without SSL", findings[0].Message)
+}
+
+func (s *AuditTestSuite) TestAuditDefaultRules() {
+	info := s.nodeInfo()
+	info.Configuration.AdminListeners = []*NodeInfoListener{{Ip: "127.0.0.1", Listener: "127.0.0.1:8001"}}
+	info.Configuration.Database = "cassandra"
+	info.Configuration.TrustedIps = []interface{}{"10.0.0.0/8", "0.0.0.0/0", "::/0"}
+
+	findings := NewAuditor().Audit(info)
+
+	s.assert.Equal(
+		[]string{"cassandra-ssl-disabled", "trusted-ips-open", "trusted-ips-open", "anonymous-reports-enabled", "server-tokens-exposed"},
+		s.rules(findings),
+	)
+	s.assert.Equal("high", findings[1].Severity.String())
+	s.assert.Equal("low", findings[3].Severity.String())
+}
+
+func (s *AuditTestSuite) TestAuditCustomRules() {
+	rule := &AuditRule{
+		Name:     "log-level-debug",
+		Severity: SeverityMedium,
+		Check: func(info *NodeInfo) []string {
+			if info.Configuration.LogLevel != "debug" {
+				return nil
+			}
+
+			return []string{"Debug logs may leak credentials"}
+		},
+	}
+
+	info := s.nodeInfo()
+	info.Configuration.LogLevel = "debug"
+
+	findings := NewAuditor(rule).Audit(info)
+
+	s.assert.Len(findings, 1)
+	s.assert.Equal("medium", findings[0].Severity.String())
+	s.assert.Empty(NewAuditor(rule).Audit(&NodeInfo{}))
+}
+
+func (s *AuditTestSuite) TestTrustedIpsWithSpaces() {
+	info := s.nodeInfo()
+	info.Configuration.TrustedIps = "10.0.0.0/8, 0.0.0.0/0"
+
+	findings := NewAuditor().Audit(info)
+
+	s.assert.Contains(s.rules(findings), "trusted-ips-open")
+}
+
+func (s *AuditTestSuite) TestDatabaseSSLNotVerified() {
+	info := s.nodeInfo()
+	info.Configuration.PostgresSSL = true
+
+	findings := NewAuditor().Audit(info)
+
+	s.assert.Equal(
+		[]string{"admin-listen-without-ssl", "database-ssl-not-verified", "anonymous-reports-enabled", "server-tokens-exposed"},
+		s.rules(findings),
+	)
+	s.assert.Equal(SeverityMedium, findings[1].Severity)
+	s.assert.Equal("Postgres SSL certificate is not verified", findings[1].Message)
+
+	info.Configuration.PostgresSSLVerify = true
+
+	s.assert.NotContains(s.rules(NewAuditor().Audit(info)), "database-ssl-not-verified")
+}
+
+func (s *AuditTestSuite) TestTrustedIpsAsString() {
+	s.assert.Equal([]string{"0.0.0.0/0", "::/0"}, interfaceStrings("0.0.0.0/0,::/0"))
+	s.assert.Equal([]string{"10.0.0.0/8", "0.0.0.0/0"}, interfaceStrings("10.0.0.0/8, 0.0.0.0/0"))
+	s.assert.Equal([]string{"0.0.0.0/0"}, interfaceStrings([]interface{}{" 0.0.0.0/0 ", ""}))
+	s.assert.Nil(interfaceStrings(map[string]interface{}{}))
+	s.assert.Equal("unknown", Severity(0).String())
+}
+
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
@@ -0,0 +1,212 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// CircuitClosed lets every request reach the Admin API.
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen fails every request fast until the node recovers.
+	CircuitOpen
+)
+
+// ErrCircuitOpen is returned while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("Circuit breaker is open")
+
+type (
+	// CircuitState it's the state of the circuit breaker.
+	CircuitState int
+
+	// CircuitBreaker fails fast the API calls when the Admin API is unhealthy.
+	CircuitBreaker struct {
+		// Kongo client used to probe the node status.
+		client *Kongo
+
+		// Circuit breaker options.
+		options CircuitBreakerOptions
+
+		mu       sync.Mutex
+		state    CircuitState
+		openedAt time.Time
+		results  []bool
+		next     int
+		probing  bool
+
+		// Clock used to compute the open timeout.
+		now func() time.Time
+	}
+
+	// CircuitBreakerOptions stores the options you can set for the circuit breaker.
+	CircuitBreakerOptions struct {
+		// Failure rate, between 0 and 1, that trips the circuit. Defaults to 0.5.
+		FailureRate float64
+
+		// Minimum number of requests before the failure rate is evaluated. Defaults to 10.
+		MinRequests int
+
+		// Number of most recent requests used to compute the failure rate. Defaults to 20.
+		WindowSize int
+
+		// Time the circuit stays open before probing the node status. Defaults to 30 seconds.
+		OpenTimeout time.Duration
+	}
+
+	// probeContextKey it's the context key used to flag the node status probe.
+	probeContextKey struct{}
+)
+
+// NewCircuitBreaker returns a circuit breaker for the client. Use its interceptor to enable it.
+func NewCircuitBreaker(client *Kongo, options *CircuitBreakerOptions) *CircuitBreaker {
+	opts := CircuitBreakerOptions{}
+
+	if options != nil {
+		opts = *options
+	}
+
+	if opts.FailureRate <= 0 || opts.FailureRate > 1 {
+		opts.FailureRate = 0.5
+	}
+
+	if opts.WindowSize <= 0 {
+		opts.WindowSize = 20
+	}
+
+	if opts.MinRequests <= 0 {
+		opts.MinRequests = 10
+	}
+
+	if opts.MinRequests > opts.WindowSize {
+		opts.MinRequests = opts.WindowSize
+	}
+
+	if opts.OpenTimeout <= 0 {
+		opts.OpenTimeout = 30 * time.Second
+	}
+
+	return &CircuitBreaker{client: client, options: opts, now: time.Now}
+}
+
+// State returns the current state of the circuit.
+func (c *CircuitBreaker) State() CircuitState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.state
+}
+
+// Interceptor returns an interceptor that fails fast with ErrCircuitOpen while the circuit is open.
+func (c *CircuitBreaker) Interceptor() Interceptor {
+	return func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		if req.Context().Value(probeContextKey{}) != nil {
+			return next(req, value)
+		}
+
+		if !c.allow(req.Context()) {
+			return nil, ErrCircuitOpen
+		}
+
+		res, err := next(req, value)
+
+		// a call canceled or expired by the caller says nothing about the node
+		if req.Context().Err() != nil {
+			return res, err
+		}
+
+		c.record(res, err)
+
+		return res, err
+	}
+}
+
+// allow reports whether the request can be sent, probing the node when the open timeout has elapsed.
+func (c *CircuitBreaker) allow(ctx context.Context) bool {
+	c.mu.Lock()
+
+	if c.state == CircuitClosed {
+		c.mu.Unlock()
+
+		return true
+	}
+
+	if c.probing || c.now().Sub(c.openedAt) < c.options.OpenTimeout {
+		c.mu.Unlock()
+
+		return false
+	}
+
+	c.probing = true
+	c.mu.Unlock()
+
+	healthy := c.probe(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+
+	if !healthy {
+		c.openedAt = c.now()
+
+		return false
+	}
+
+	c.state = CircuitClosed
+	c.results = c.results[:0]
+	c.next = 0
+
+	return true
+}
+
+// probe checks whether the node is serving and the database is reachable.
+func (c *CircuitBreaker) probe(ctx context.Context) bool {
+	status, _, err := c.client.Node.StatusWithContext(context.WithValue(withoutCallInterceptors(ctx), probeContextKey{}, true))
+
+	if err != nil {
+		return false
+	}
+
+	return status.Database != nil && status.Database.Reachable
+}
+
+// record stores the request result and trips the circuit when the failure rate is reached.
+func (c *CircuitBreaker) record(res *http.Response, err error) {
+	failed := err != nil && (res == nil || res.StatusCode >= http.StatusInternalServerError)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != CircuitClosed {
+		return
+	}
+
+	if len(c.results) < c.options.WindowSize {
+		c.results = append(c.results, failed)
+	} else {
+		c.results[c.next] = failed
+	}
+
+	c.next = (c.next + 1) % c.options.WindowSize
+
+	if len(c.results) < c.options.MinRequests {
+		return
+	}
+
+	failures := 0
+
+	for _, f := range c.results {
+		if f {
+			failures++
+		}
+	}
+
+	if float64(failures)/float64(len(c.results)) >= c.options.FailureRate {
+		c.state = CircuitOpen
+		c.openedAt = c.now()
+	}
+}
@@ -0,0 +1,165 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type CircuitBreakerTestSuite struct {
+	BaseTestSuite
+
+	breaker *CircuitBreaker
+	clock   time.Time
+}
+
+func (s *CircuitBreakerTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	s.clock = time.Date(2018, 4, 4, 0, 0, 0, 0, time.UTC)
+	s.breaker = NewCircuitBreaker(s.client, &CircuitBreakerOptions{
+		FailureRate: 0.5,
+		MinRequests: 4,
+		WindowSize:  4,
+		OpenTimeout: time.Minute,
+	})
+	s.breaker.now = func() time.Time { return s.clock }
+
+	s.client.Use(s.breaker.Interceptor())
+}
+
+func (s *CircuitBreakerTestSuite) TestDefaultOptions() {
+	breaker := NewCircuitBreaker(s.client, nil)
+
+	s.assert.Equal(0.5, breaker.options.FailureRate)
+	s.assert.Equal(10, breaker.options.MinRequests)
+	s.assert.Equal(20, breaker.options.WindowSize)
+	s.assert.Equal(30*time.Second, breaker.options.OpenTimeout)
+	s.assert.Equal(CircuitClosed, breaker.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestClientErrorsDoNotTrip() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for i := 0; i < 4; i++ {
+		s.client.Services.Get("foo")
+	}
+
+	s.assert.Equal(CircuitClosed, s.breaker.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestCallerCancellationsDoNotTrip() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	for i := 0; i < 4; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+
+		_, _, err := s.client.Services.GetWithContext(ctx, "foo")
+
+		cancel()
+
+		s.assert.True(errors.Is(err, context.DeadlineExceeded))
+	}
+
+	s.assert.Equal(CircuitClosed, s.breaker.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestTripAndRecover() {
+	reachable := false
+	calls := 0
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		if calls%2 == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"database": {"reachable": %t}}`, reachable)
+	})
+
+	for i := 0; i < 4; i++ {
+		s.client.Services.Get("foo")
+	}
+
+	s.assert.Equal(CircuitOpen, s.breaker.State())
+
+	_, res, err := s.client.Services.Get("foo")
+
+	s.assert.Nil(res)
+	s.assert.Equal(ErrCircuitOpen, err)
+	s.assert.Equal(4, calls)
+
+	s.clock = s.clock.Add(2 * time.Minute)
+
+	_, _, err = s.client.Services.Get("foo")
+
+	s.assert.Equal(ErrCircuitOpen, err)
+	s.assert.Equal(CircuitOpen, s.breaker.State())
+
+	s.clock = s.clock.Add(2 * time.Minute)
+	reachable = true
+
+	svc, _, err := s.client.Services.Get("foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+	s.assert.Equal(CircuitClosed, s.breaker.State())
+}
+
+func (s *CircuitBreakerTestSuite) TestProbeDropsCallInterceptors() {
+	failing := true
+
+	s.mux.HandleFunc("/ws/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	ctx := ContextWithInterceptors(context.Background(), func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		req.URL.Path = "/ws" + req.URL.Path
+
+		return next(req, value)
+	})
+
+	for i := 0; i < 4; i++ {
+		s.client.Services.GetWithContext(ctx, "foo")
+	}
+
+	s.assert.Equal(CircuitOpen, s.breaker.State())
+
+	s.clock = s.clock.Add(2 * time.Minute)
+	failing = false
+
+	svc, _, err := s.client.Services.GetWithContext(ctx, "foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+	s.assert.Equal(CircuitClosed, s.breaker.State())
+}
+
+func TestCircuitBreakerTestSuite(t *testing.T) {
+	suite.Run(t, new(CircuitBreakerTestSuite))
+}
@@ -0,0 +1,108 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type (
+	// streamBody it's a value that makes Do hand over the response body instead of consuming it.
+	streamBody struct {
+		body io.ReadCloser
+	}
+)
+
+// Call sends a request to an Admin API endpoint, e.g. /plugins?name=cors, and unmarshals the response into T.
+// The body, when not nil, is JSON encoded. The request passes through the client interceptors like the service
+// methods do.
+func Call[T any](ctx context.Context, k *Kongo, method, path string, body interface{}) (T, *Response, error) {
+	var value T
+
+	req, err := k.newCallRequest(ctx, method, path, body)
+
+	if err != nil {
+		return value, nil, err
+	}
+
+	res, err := k.Do(req, &value)
+
+	if err != nil {
+		var zero T
+
+		return zero, newResponse(res, &value), err
+	}
+
+	return value, newResponse(res, &value), nil
+}
+
+// CallRaw sends a request to an Admin API endpoint and returns the response without reading its body, the caller
+// must close it. Error responses are returned as ErrorResponse with the body already closed, and the responses
+// of interceptors that didn't reach the server are an error as they have no body to hand over.
+func CallRaw(ctx context.Context, k *Kongo, method, path string, body interface{}) (*Response, error) {
+	req, err := k.newCallRequest(ctx, method, path, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stream := new(streamBody)
+
+	res, err := k.Do(req, stream)
+
+	if err != nil {
+		return newResponse(res, nil), err
+	}
+
+	// an interceptor can answer without reaching the server, leaving no body to hand over
+	if res == nil || stream.body == nil {
+		if res != nil && res.Body != nil {
+			res.Body.Close()
+		}
+
+		return newResponse(res, nil), errors.New("Response body is not available")
+	}
+
+	res.Body = stream.body
+
+	return newResponse(res, nil), nil
+}
+
+// newCallRequest creates the request of an endpoint path relative to the base URL. Paths without a leading
+// slash are taken from the root, so the base path applies to them as well.
+func (k *Kongo) newCallRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	ctx = withOperation(ctx, "Call", method, "", "")
+
+	resource, err := url.Parse(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.Scheme != "" || resource.Host != "" {
+		return nil, errors.New("Call path must be relative to the base URL")
+	}
+
+	if !strings.HasPrefix(resource.Path, "/") {
+		resource.Path = "/" + resource.Path
+
+		if resource.RawPath != "" {
+			resource.RawPath = "/" + resource.RawPath
+		}
+	}
+
+	return k.NewRequest(ctx, method, resource, body)
+}
+
+// isRawValue reports whether the value receives the response body as is instead of its JSON decoding.
+func isRawValue(value interface{}) bool {
+	switch value.(type) {
+	case io.Writer, *streamBody:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,184 @@
+package kongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+type CallTestSuite struct {
+	BaseTestSuite
+}
+
+type mockPlugin struct {
+	Id     string                 `json:"id"`
+	Name   string                 `json:"name"`
+	Config map[string]interface{} `json:"config"`
+}
+
+func (s *CallTestSuite) TestCall() {
+	s.mux.HandleFunc("/plugins", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+		s.assert.Equal(userAgent, r.Header.Get("User-Agent"))
+
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal("cors", body["name"])
+
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `{"id": "1", "name": "cors", "config": {"max_age": 3600}}`)
+	})
+
+	plugin, res, err := Call[*mockPlugin](context.Background(), s.client, http.MethodPost, "/plugins", map[string]string{"name": "cors"})
+
+	s.assert.Nil(err)
+	s.assert.IsType(&Response{}, res)
+	s.assert.Equal(http.StatusCreated, res.StatusCode)
+	s.assert.Equal("1", plugin.Id)
+	s.assert.Equal(float64(3600), plugin.Config["max_age"])
+}
+
+func (s *CallTestSuite) TestCallWithQuery() {
+	s.mux.HandleFunc("/plugins", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("cors", r.URL.Query().Get("name"))
+
+		fmt.Fprint(w, `{"data": [{"id": "1", "name": "cors"}]}`)
+	})
+
+	root, _, err := Call[map[string][]mockPlugin](context.Background(), s.client, http.MethodGet, "/plugins?name=cors", nil)
+
+	s.assert.Nil(err)
+	s.assert.Len(root["data"], 1)
+}
+
+func (s *CallTestSuite) TestCallPathWithoutLeadingSlash() {
+	s.mux.HandleFunc("/admin-api/plugins", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("cors", r.URL.Query().Get("name"))
+
+		fmt.Fprint(w, `{"id": "1", "name": "cors"}`)
+	})
+
+	k, _ := NewWithOptions(s.server.URL, WithBasePath("/admin-api"))
+
+	plugin, _, err := Call[*mockPlugin](context.Background(), k, http.MethodGet, "plugins?name=cors", nil)
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", plugin.Id)
+}
+
+func (s *CallTestSuite) TestCallRejectsAbsoluteURL() {
+	_, _, err := Call[*mockPlugin](context.Background(), s.client, http.MethodGet, "http://example.com/plugins", nil)
+
+	s.assert.EqualError(err, "Call path must be relative to the base URL")
+}
+
+func (s *CallTestSuite) TestCallNoContent() {
+	s.mux.HandleFunc("/plugins/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	value, res, err := Call[interface{}](context.Background(), s.client, http.MethodDelete, "/plugins/1", nil)
+
+	s.assert.Nil(err)
+	s.assert.Nil(value)
+	s.assert.Equal(http.StatusNoContent, res.StatusCode)
+}
+
+func (s *CallTestSuite) TestCallReturnsHttpError() {
+	s.mux.HandleFunc("/plugins/1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+
+		fmt.Fprint(w, `{"message": "Not found"}`)
+	})
+
+	plugin, res, err := Call[*mockPlugin](context.Background(), s.client, http.MethodGet, "/plugins/1", nil)
+
+	s.assert.Nil(plugin)
+	s.assert.Equal(http.StatusNotFound, res.StatusCode)
+	s.assert.EqualError(err, "404 Not found")
+}
+
+func (s *CallTestSuite) TestCallRunsInterceptors() {
+	var op Operation
+
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		op, _ = OperationFromContext(req.Context())
+
+		return next(req, value)
+	})
+
+	s.mux.HandleFunc("/upstreams", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	_, _, err := Call[map[string]interface{}](context.Background(), s.client, http.MethodGet, "/upstreams", nil)
+
+	s.assert.Nil(err)
+	s.assert.Equal("kongo.Call.GET", op.String())
+}
+
+func (s *CallTestSuite) TestCallRaw() {
+	s.mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, "_format_version: \"3.0\"\n")
+	})
+
+	res, err := CallRaw(context.Background(), s.client, http.MethodGet, "/config", nil)
+
+	s.assert.Nil(err)
+
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+
+	s.assert.Nil(err)
+	s.assert.Equal("_format_version: \"3.0\"\n", string(data))
+}
+
+func (s *CallTestSuite) TestCallRawReturnsHttpError() {
+	s.mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprint(w, `{"message": "Invalid format"}`)
+	})
+
+	res, err := CallRaw(context.Background(), s.client, http.MethodPost, "/config", nil)
+
+	s.assert.Equal(http.StatusBadRequest, res.StatusCode)
+	s.assert.EqualError(err, "400 Invalid format")
+}
+
+func (s *CallTestSuite) TestCallRawShortCircuited() {
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		return nil, nil
+	})
+
+	res, err := CallRaw(context.Background(), s.client, http.MethodGet, "/config", nil)
+
+	s.assert.Nil(res)
+	s.assert.EqualError(err, "Response body is not available")
+
+	client, _ := New(nil, s.server.URL)
+	client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+	})
+
+	res, err = CallRaw(context.Background(), client, http.MethodGet, "/config", nil)
+
+	s.assert.Equal(http.StatusOK, res.StatusCode)
+	s.assert.EqualError(err, "Response body is not available")
+}
+
+func TestCallTestSuite(t *testing.T) {
+	suite.Run(t, new(CallTestSuite))
+}
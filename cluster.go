@@ -0,0 +1,158 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+const (
+	defaultClusterConcurrency = 4
+)
+
+type (
+	// Cluster queries several Kong nodes concurrently.
+	Cluster struct {
+		// Kongo clients, one per node.
+		Nodes []*Kongo
+
+		// Maximum number of nodes queried at the same time.
+		concurrency int
+	}
+
+	// ClusterNode it's the result of querying a single node.
+	ClusterNode struct {
+		// Kongo client of the node.
+		Client *Kongo
+
+		// The node information, nil when the request failed.
+		Info *NodeInfo
+
+		// Error returned by the node information request.
+		InfoError error
+
+		// The node status, nil when the request failed.
+		Status *NodeStatus
+
+		// Error returned by the node status request.
+		StatusError error
+	}
+
+	// ClusterReport it's the result of querying every node of the cluster.
+	ClusterReport struct {
+		// Results by node, in the same order of the cluster nodes.
+		Nodes []*ClusterNode
+
+		// Sum of the active connections of the nodes.
+		ConnectionsActive int
+
+		// Sum of the requests handled by the nodes.
+		TotalRequests int
+
+		// Number of nodes that reached the database.
+		DatabaseReachable int
+
+		// Number of nodes that could not reach the database or whose status is unknown.
+		DatabaseUnreachable int
+	}
+)
+
+// NewCluster returns a cluster of the given admin URLs. The concurrency bounds the number of nodes queried
+// at the same time, defaults to 4 when it's not positive.
+func NewCluster(client *http.Client, baseURLs []string, concurrency int) (*Cluster, error) {
+	if len(baseURLs) == 0 {
+		return nil, errors.New("Empty URL is not allowed")
+	}
+
+	if concurrency <= 0 {
+		concurrency = defaultClusterConcurrency
+	}
+
+	c := &Cluster{concurrency: concurrency}
+
+	for _, baseURL := range baseURLs {
+		k, err := New(client, baseURL)
+
+		if err != nil {
+			return nil, err
+		}
+
+		c.Nodes = append(c.Nodes, k)
+	}
+
+	return c, nil
+}
+
+// Inspect retrieves the information and the status of every node and aggregates them.
+func (c *Cluster) Inspect(ctx context.Context) *ClusterReport {
+	report := &ClusterReport{Nodes: make([]*ClusterNode, len(c.Nodes))}
+	jobs := make(chan int)
+	concurrency := c.concurrency
+
+	// clusters built without NewCluster have no concurrency set
+	if concurrency <= 0 {
+		concurrency = defaultClusterConcurrency
+	}
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency && w < len(c.Nodes); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				report.Nodes[i] = inspectNode(ctx, c.Nodes[i])
+			}
+		}()
+	}
+
+	for i := range c.Nodes {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for _, node := range report.Nodes {
+		if node.Status == nil || node.Status.Database == nil || !node.Status.Database.Reachable {
+			report.DatabaseUnreachable++
+		} else {
+			report.DatabaseReachable++
+		}
+
+		if node.Status != nil && node.Status.Server != nil {
+			report.ConnectionsActive += node.Status.Server.ConnectionsActive
+			report.TotalRequests += node.Status.Server.TotalRequests
+		}
+	}
+
+	return report
+}
+
+// Err returns the first error found on the nodes, if any.
+func (r *ClusterReport) Err() error {
+	for _, node := range r.Nodes {
+		if node.InfoError != nil {
+			return node.InfoError
+		}
+
+		if node.StatusError != nil {
+			return node.StatusError
+		}
+	}
+
+	return nil
+}
+
+// inspectNode retrieves the information and the status of a single node.
+func inspectNode(ctx context.Context, k *Kongo) *ClusterNode {
+	node := &ClusterNode{Client: k}
+
+	node.Info, _, node.InfoError = k.Node.InfoWithContext(ctx)
+	node.Status, _, node.StatusError = k.Node.StatusWithContext(ctx)
+
+	return node
+}
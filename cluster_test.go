@@ -0,0 +1,109 @@
+package kongo
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ClusterTestSuite struct {
+	BaseTestSuite
+
+	servers []*httptest.Server
+}
+
+func (s *ClusterTestSuite) TearDownTest() {
+	s.BaseTestSuite.TearDownTest()
+
+	for _, server := range s.servers {
+		server.Close()
+	}
+}
+
+func (s *ClusterTestSuite) node(status string) string {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		file, _ := s.LoadFixture("fixtures/node_info_payload.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if status == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		fmt.Fprint(w, status)
+	})
+
+	server := httptest.NewServer(mux)
+	s.servers = append(s.servers, server)
+
+	return server.URL
+}
+
+func (s *ClusterTestSuite) TestFactoryWithoutURL() {
+	_, err := NewCluster(nil, nil, 0)
+
+	s.assert.EqualError(err, "Empty URL is not allowed")
+
+	_, err = NewCluster(nil, []string{""}, 0)
+
+	s.assert.EqualError(err, "Empty URL is not allowed")
+}
+
+func (s *ClusterTestSuite) TestInspect() {
+	urls := []string{
+		s.node(`{"database": {"reachable": true}, "server": {"connections_active": 2, "total_requests": 10}}`),
+		s.node(`{"database": {"reachable": false}, "server": {"connections_active": 3, "total_requests": 5}}`),
+		s.node(""),
+	}
+
+	cluster, _ := NewCluster(nil, urls, 2)
+	report := cluster.Inspect(context.Background())
+
+	s.assert.Len(report.Nodes, 3)
+	s.assert.Equal(5, report.ConnectionsActive)
+	s.assert.Equal(15, report.TotalRequests)
+	s.assert.Equal(1, report.DatabaseReachable)
+	s.assert.Equal(2, report.DatabaseUnreachable)
+
+	s.assert.Equal(urls[0], report.Nodes[0].Client.BaseURL.String())
+	s.assert.NotNil(report.Nodes[0].Info)
+	s.assert.Nil(report.Nodes[0].StatusError)
+	s.assert.NotNil(report.Nodes[2].Info)
+	s.assert.Nil(report.Nodes[2].Status)
+	s.assert.EqualError(report.Nodes[2].StatusError, "500 Request error")
+	s.assert.EqualError(report.Err(), "500 Request error")
+}
+
+func (s *ClusterTestSuite) TestInspectWithoutErrors() {
+	cluster, _ := NewCluster(nil, []string{s.node(`{"database": {"reachable": true}}`)}, 0)
+	report := cluster.Inspect(context.Background())
+
+	s.assert.Nil(report.Err())
+	s.assert.Equal(1, report.DatabaseReachable)
+}
+
+func (s *ClusterTestSuite) TestInspectClusterLiteral() {
+	k, _ := New(nil, s.node(`{"database": {"reachable": true}}`))
+
+	cluster := &Cluster{Nodes: []*Kongo{k}}
+	report := cluster.Inspect(context.Background())
+
+	s.assert.Len(report.Nodes, 1)
+	s.assert.Equal(1, report.DatabaseReachable)
+}
+
+func TestClusterTestSuite(t *testing.T) {
+	suite.Run(t, new(ClusterTestSuite))
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"github.com/fabiorphp/kongo"
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+const (
+	namespace = "kong_node"
+)
+
+type (
+	// exporter collects the status and information of Kong nodes on every scrape.
+	exporter struct {
+		// Cluster of the scraped nodes.
+		cluster *kongo.Cluster
+
+		// Timeout of a scrape.
+		timeout time.Duration
+
+		up                  *prometheus.Desc
+		info                *prometheus.Desc
+		databaseReachable   *prometheus.Desc
+		connections         *prometheus.Desc
+		connectionsAccepted *prometheus.Desc
+		connectionsHandled  *prometheus.Desc
+		requests            *prometheus.Desc
+		timers              *prometheus.Desc
+	}
+)
+
+// newExporter returns an exporter of the cluster nodes.
+func newExporter(cluster *kongo.Cluster, timeout time.Duration) *exporter {
+	target := []string{"target"}
+
+	return &exporter{
+		cluster: cluster,
+		timeout: timeout,
+
+		up: prometheus.NewDesc(
+			namespace+"_up", "Whether the node status could be retrieved.", target, nil,
+		),
+		info: prometheus.NewDesc(
+			namespace+"_info", "Kong node information.", []string{"target", "version", "hostname", "lua_version"}, nil,
+		),
+		databaseReachable: prometheus.NewDesc(
+			namespace+"_database_reachable", "Whether the node reaches the database.", target, nil,
+		),
+		connections: prometheus.NewDesc(
+			namespace+"_connections", "Number of client connections by state.", []string{"target", "state"}, nil,
+		),
+		connectionsAccepted: prometheus.NewDesc(
+			namespace+"_connections_accepted_total", "Number of accepted client connections.", target, nil,
+		),
+		connectionsHandled: prometheus.NewDesc(
+			namespace+"_connections_handled_total", "Number of handled client connections.", target, nil,
+		),
+		requests: prometheus.NewDesc(
+			namespace+"_requests_total", "Number of client requests.", target, nil,
+		),
+		timers: prometheus.NewDesc(
+			namespace+"_timers", "Number of nginx timers by state.", []string{"target", "state"}, nil,
+		),
+	}
+}
+
+// Describe sends the metrics descriptors to the channel.
+func (e *exporter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- e.up
+	ch <- e.info
+	ch <- e.databaseReachable
+	ch <- e.connections
+	ch <- e.connectionsAccepted
+	ch <- e.connectionsHandled
+	ch <- e.requests
+	ch <- e.timers
+}
+
+// Collect scrapes the nodes and sends the metrics to the channel.
+func (e *exporter) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	for _, node := range e.cluster.Inspect(ctx).Nodes {
+		target := node.Client.BaseURL.String()
+
+		if info := node.Info; info != nil {
+			ch <- prometheus.MustNewConstMetric(e.info, prometheus.GaugeValue, 1, target, info.Version, info.Hostname, info.LuaVersion)
+
+			if info.Timers != nil {
+				ch <- prometheus.MustNewConstMetric(e.timers, prometheus.GaugeValue, float64(info.Timers.Pending), target, "pending")
+				ch <- prometheus.MustNewConstMetric(e.timers, prometheus.GaugeValue, float64(info.Timers.Running), target, "running")
+			}
+		}
+
+		status := node.Status
+
+		if status == nil {
+			ch <- prometheus.MustNewConstMetric(e.up, prometheus.GaugeValue, 0, target)
+
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(e.up, prometheus.GaugeValue, 1, target)
+
+		if status.Database != nil {
+			ch <- prometheus.MustNewConstMetric(e.databaseReachable, prometheus.GaugeValue, boolValue(status.Database.Reachable), target)
+		}
+
+		if server := status.Server; server != nil {
+			ch <- prometheus.MustNewConstMetric(e.connections, prometheus.GaugeValue, float64(server.ConnectionsActive), target, "active")
+			ch <- prometheus.MustNewConstMetric(e.connections, prometheus.GaugeValue, float64(server.ConnectionsReading), target, "reading")
+			ch <- prometheus.MustNewConstMetric(e.connections, prometheus.GaugeValue, float64(server.ConnectionsWriting), target, "writing")
+			ch <- prometheus.MustNewConstMetric(e.connections, prometheus.GaugeValue, float64(server.ConnectionsWaiting), target, "waiting")
+			ch <- prometheus.MustNewConstMetric(e.connectionsAccepted, prometheus.CounterValue, float64(server.ConnectionsAccepted), target)
+			ch <- prometheus.MustNewConstMetric(e.connectionsHandled, prometheus.CounterValue, float64(server.ConnectionsHandled), target)
+			ch <- prometheus.MustNewConstMetric(e.requests, prometheus.CounterValue, float64(server.TotalRequests), target)
+		}
+	}
+}
+
+// boolValue converts a boolean into a metric value.
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"github.com/fabiorphp/kongo"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ExporterTestSuite struct {
+	suite.Suite
+
+	assert *assert.Assertions
+	server *httptest.Server
+	down   *httptest.Server
+}
+
+func (s *ExporterTestSuite) SetupTest() {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "0.13.0", "hostname": "kong-1", "lua_version": "LuaJIT 2.1.0", "timers": {"pending": 4, "running": 1}}`)
+	})
+
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"database": {"reachable": true},
+			"server": {
+				"connections_accepted": 532,
+				"connections_active": 3,
+				"connections_handled": 530,
+				"connections_reading": 0,
+				"connections_waiting": 2,
+				"connections_writing": 1,
+				"total_requests": 600
+			}
+		}`)
+	})
+
+	s.server = httptest.NewServer(mux)
+	s.down = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	s.assert = assert.New(s.T())
+}
+
+func (s *ExporterTestSuite) TearDownTest() {
+	s.server.Close()
+	s.down.Close()
+}
+
+func (s *ExporterTestSuite) TestCollect() {
+	cluster, _ := kongo.NewCluster(nil, []string{s.server.URL, s.down.URL}, 0)
+
+	expected := strings.NewReplacer("UP", s.server.URL, "DOWN", s.down.URL).Replace(`
+# HELP kong_node_connections Number of client connections by state.
+# TYPE kong_node_connections gauge
+kong_node_connections{state="active",target="UP"} 3
+kong_node_connections{state="reading",target="UP"} 0
+kong_node_connections{state="waiting",target="UP"} 2
+kong_node_connections{state="writing",target="UP"} 1
+# HELP kong_node_connections_accepted_total Number of accepted client connections.
+# TYPE kong_node_connections_accepted_total counter
+kong_node_connections_accepted_total{target="UP"} 532
+# HELP kong_node_connections_handled_total Number of handled client connections.
+# TYPE kong_node_connections_handled_total counter
+kong_node_connections_handled_total{target="UP"} 530
+# HELP kong_node_database_reachable Whether the node reaches the database.
+# TYPE kong_node_database_reachable gauge
+kong_node_database_reachable{target="UP"} 1
+# HELP kong_node_info Kong node information.
+# TYPE kong_node_info gauge
+kong_node_info{hostname="kong-1",lua_version="LuaJIT 2.1.0",target="UP",version="0.13.0"} 1
+# HELP kong_node_requests_total Number of client requests.
+# TYPE kong_node_requests_total counter
+kong_node_requests_total{target="UP"} 600
+# HELP kong_node_timers Number of nginx timers by state.
+# TYPE kong_node_timers gauge
+kong_node_timers{state="pending",target="UP"} 4
+kong_node_timers{state="running",target="UP"} 1
+# HELP kong_node_up Whether the node status could be retrieved.
+# TYPE kong_node_up gauge
+kong_node_up{target="DOWN"} 0
+kong_node_up{target="UP"} 1
+`)
+
+	err := testutil.CollectAndCompare(newExporter(cluster, time.Second), strings.NewReader(expected))
+
+	s.assert.Nil(err)
+}
+
+func (s *ExporterTestSuite) TestTargetsFlag() {
+	var t targets
+
+	t.Set("http://a:8001, http://b:8001")
+	t.Set("http://c:8001")
+
+	s.assert.Equal(targets{"http://a:8001", "http://b:8001", "http://c:8001"}, t)
+	s.assert.Equal("http://a:8001,http://b:8001,http://c:8001", t.String())
+}
+
+func TestExporterTestSuite(t *testing.T) {
+	suite.Run(t, new(ExporterTestSuite))
+}
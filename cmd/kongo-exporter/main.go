@@ -0,0 +1,68 @@
+// Command kongo-exporter exposes the status and information of Kong nodes in the Prometheus text format.
+//
+// Usage:
+//
+//	kongo-exporter -target http://127.0.0.1:8001 -target http://127.0.0.2:8001
+package main
+
+import (
+	"flag"
+	"github.com/fabiorphp/kongo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type (
+	// targets it's a repeatable flag of Kong admin URLs.
+	targets []string
+)
+
+// String returns the targets comma separated.
+func (t *targets) String() string {
+	return strings.Join(*t, ",")
+}
+
+// Set appends the comma separated targets.
+func (t *targets) Set(value string) error {
+	for _, target := range strings.Split(value, ",") {
+		if target = strings.TrimSpace(target); target != "" {
+			*t = append(*t, target)
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	var adminURLs targets
+
+	flag.Var(&adminURLs, "target", "Kong admin URL, repeatable or comma separated.")
+	listenAddress := flag.String("listen-address", ":9542", "Address to expose the metrics.")
+	metricsPath := flag.String("metrics-path", "/metrics", "Path to expose the metrics.")
+	timeout := flag.Duration("timeout", 5*time.Second, "Timeout of a scrape.")
+	concurrency := flag.Int("concurrency", 4, "Number of nodes scraped at the same time.")
+
+	flag.Parse()
+
+	if len(adminURLs) == 0 {
+		adminURLs = targets{"http://127.0.0.1:8001"}
+	}
+
+	cluster, err := kongo.NewCluster(&http.Client{Timeout: *timeout}, adminURLs, *concurrency)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(newExporter(cluster, *timeout))
+
+	http.Handle(*metricsPath, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	log.Printf("Listening on %s", *listenAddress)
+	log.Fatal(http.ListenAndServe(*listenAddress, nil))
+}
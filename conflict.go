@@ -0,0 +1,76 @@
+package kongo
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// ErrConflict is returned when the entity was changed since the caller last read it.
+var ErrConflict = errors.New("Entity was changed concurrently")
+
+// unchanged reports whether the current entity is the version last seen by the caller. The update dates have
+// a one second resolution, so the content hashes are compared too.
+func unchanged(seenUpdatedAt, currentUpdatedAt Time, seen, current interface{}) (bool, error) {
+	if !seenUpdatedAt.Equal(currentUpdatedAt.Time) {
+		return false, nil
+	}
+
+	seenHash, err := contentHash(seen)
+
+	if err != nil {
+		return false, err
+	}
+
+	currentHash, err := contentHash(current)
+
+	if err != nil {
+		return false, err
+	}
+
+	return seenHash == currentHash, nil
+}
+
+// contentHash returns the SHA-256 of the entity JSON representation, ignoring the field masks.
+func contentHash(entity interface{}) (string, error) {
+	data, err := json.Marshal(withoutFieldMasks(entity))
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// withoutFieldMasks returns a copy of the entity without ForceSendFields and NullFields, they only shape the
+// requests and are not part of the entity content.
+func withoutFieldMasks(entity interface{}) interface{} {
+	switch e := entity.(type) {
+	case *Service:
+		c := *e
+		c.ForceSendFields, c.NullFields = nil, nil
+
+		return &c
+	case *Route:
+		c := *e
+		c.ForceSendFields, c.NullFields = nil, nil
+
+		return &c
+	}
+
+	return entity
+}
+
+// deepCopy copies the entity through its JSON representation.
+func deepCopy(src, dst interface{}) error {
+	data, err := json.Marshal(src)
+
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,155 @@
+package kongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type ConflictTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *ConflictTestSuite) TestServiceUpdateIfUnchanged() {
+	patched := false
+
+	s.mux.HandleFunc(servicesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patched = true
+		}
+
+		fmt.Fprint(w, `{"id": "1", "host": "foo.org", "updated_at": 1520000000}`)
+	})
+
+	seen := &Service{Id: "1", Host: "foo.org", UpdatedAt: Time{time.Unix(1520000000, 0)}, ForceSendFields: []string{"Retries"}}
+
+	svc, _, err := s.client.Services.UpdateIfUnchanged("foo", seen, &Service{Host: "foo.org"})
+
+	s.assert.Nil(err)
+	s.assert.True(patched)
+	s.assert.Equal("foo.org", svc.Host)
+}
+
+func (s *ConflictTestSuite) TestServiceUpdateIfUnchangedReturnsConflict() {
+	s.mux.HandleFunc(servicesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "updated_at": 1520000060}`)
+	})
+
+	seen := &Service{Id: "1", UpdatedAt: Time{time.Unix(1520000000, 0)}}
+
+	svc, res, err := s.client.Services.UpdateIfUnchanged("foo", seen, &Service{Host: "foo.org"})
+
+	s.assert.Nil(svc)
+	s.assert.IsType(&Response{}, res)
+	s.assert.Equal(ErrConflict, err)
+}
+
+func (s *ConflictTestSuite) TestRouteUpdateIfUnchangedComparesContentWithoutUpdatedAt() {
+	s.mux.HandleFunc(routesResourcePath+"/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "paths": ["/v2"]}`)
+	})
+
+	seen := &Route{Id: "1", Paths: []string{"/v1"}}
+
+	_, _, err := s.client.Routes.UpdateIfUnchanged("1", seen, &Route{Paths: []string{"/v3"}})
+
+	s.assert.Equal(ErrConflict, err)
+}
+
+func (s *ConflictTestSuite) TestUpdateIfUnchangedComparesContentWithSameUpdatedAt() {
+	s.mux.HandleFunc(servicesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "host": "bar.org", "updated_at": 1520000000}`)
+	})
+
+	seen := &Service{Id: "1", Host: "foo.org", UpdatedAt: Time{time.Unix(1520000000, 0)}}
+
+	_, _, err := s.client.Services.UpdateIfUnchanged("foo", seen, &Service{Host: "baz.org"})
+
+	s.assert.Equal(ErrConflict, err)
+}
+
+func (s *ConflictTestSuite) TestModifyServiceRetriesOnConflict() {
+	reads := 0
+	body := map[string]interface{}{}
+
+	s.mux.HandleFunc(servicesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			json.NewDecoder(r.Body).Decode(&body)
+
+			fmt.Fprint(w, `{"id": "1", "host": "foo.org", "retries": 10}`)
+
+			return
+		}
+
+		reads++
+
+		// the second read, made by the first conditional update, sees a concurrent change
+		if reads < 2 {
+			fmt.Fprint(w, `{"id": "1", "host": "foo.org", "retries": 5, "updated_at": 1520000000}`)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "1", "host": "foo.org", "retries": 6, "updated_at": 1520000060}`)
+	})
+
+	svc, _, err := ModifyService(context.Background(), s.client.Services, "foo", 3, func(svc *Service) error {
+		svc.Retries += 4
+
+		return nil
+	})
+
+	s.assert.Nil(err)
+	s.assert.Equal(4, reads)
+	s.assert.Equal(float64(10), body["retries"])
+	s.assert.Equal(10, svc.Retries)
+}
+
+func (s *ConflictTestSuite) TestModifyRouteGivesUpAfterAttempts() {
+	reads := 0
+
+	s.mux.HandleFunc(routesResourcePath+"/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		reads++
+
+		fmt.Fprintf(w, `{"id": "1", "updated_at": %d}`, 1520000000+reads)
+	})
+
+	_, _, err := ModifyRoute(context.Background(), s.client.Routes, "1", 2, func(route *Route) error {
+		route.Paths = []string{"/api"}
+
+		return nil
+	})
+
+	s.assert.Equal(ErrConflict, err)
+	s.assert.Equal(4, reads)
+}
+
+func (s *ConflictTestSuite) TestModifyRouteStopsOnMutationError() {
+	s.mux.HandleFunc(routesResourcePath+"/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	_, _, err := ModifyRoute(context.Background(), s.client.Routes, "1", 3, func(route *Route) error {
+		return fmt.Errorf("Invalid route")
+	})
+
+	s.assert.EqualError(err, "Invalid route")
+}
+
+func TestConflictTestSuite(t *testing.T) {
+	suite.Run(t, new(ConflictTestSuite))
+}
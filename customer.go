@@ -2,6 +2,7 @@ package kongo
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/google/go-querystring/query"
 	"net/http"
 	"net/url"
@@ -16,34 +17,34 @@ type (
 	// Customers manages the Kong customer rules.
 	Customers interface {
 		// Create creates a new customer.
-		Create(customer *Customer) (*Customer, *http.Response, error)
+		Create(customer *Customer) (*Customer, *Response, error)
 
 		// CreateWithContext creates a new customer.
-		CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *http.Response, error)
+		CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *Response, error)
 
 		// Delete deletes registered customer by ID or Username.
-		Delete(idOrUsername string) (*http.Response, error)
+		Delete(idOrUsername string) (*Response, error)
 
 		// DeleteWithContext deletes registered customer by ID or Username.
-		DeleteWithContext(ctx context.Context, idOrUsername string) (*http.Response, error)
+		DeleteWithContext(ctx context.Context, idOrUsername string) (*Response, error)
 
 		// Get retrieves registered customer by ID or username.
-		Get(idOrUsername string) (*Customer, *http.Response, error)
+		Get(idOrUsername string) (*Customer, *Response, error)
 
 		// GetWithContext retrieves registered customer by ID or username.
-		GetWithContext(ctx context.Context, idOrUsername string) (*Customer, *http.Response, error)
+		GetWithContext(ctx context.Context, idOrUsername string) (*Customer, *Response, error)
 
 		// List retrieves a list of registered customers.
-		List(options *ListCustomersOptions) ([]*Customer, *http.Response, error)
+		List(options *ListCustomersOptions) ([]*Customer, *Response, error)
 
 		// ListWithContext retrieves a list of registered customers.
-		ListWithContext(ctx context.Context, options *ListCustomersOptions) ([]*Customer, *http.Response, error)
+		ListWithContext(ctx context.Context, options *ListCustomersOptions) ([]*Customer, *Response, error)
 
 		// Update updates a customer registered by ID or Username.
-		Update(idOrUsername string, customer *Customer) (*Customer, *http.Response, error)
+		Update(idOrUsername string, customer *Customer) (*Customer, *Response, error)
 
 		// UpdateWithContext updates a customer registered by ID or Username.
-		UpdateWithContext(ctx context.Context, idOrUsername string, customer *Customer) (*Customer, *http.Response, error)
+		UpdateWithContext(ctx context.Context, idOrUsername string, customer *Customer) (*Customer, *Response, error)
 	}
 
 	// CustomersService it's a concrete instance of customers.
@@ -63,14 +64,32 @@ type (
 		// The identification of customer registered.
 		Id string `json:"id"`
 
+		// An optional set of strings associated with the consumer, for grouping and filtering. Requires Kong 1.1 or later.
+		Tags []string `json:"tags,omitempty"`
+
 		// The unique username of the consumer. You must send either this field or custom_id with the request.
 		Username string `json:"username,omitempty"`
+
+		// Fields returned by Kong that are not mapped yet, re-emitted when the customer is marshalled.
+		Extras map[string]json.RawMessage `json:"-"`
+
+		// Field names, e.g. CustomId, sent on create and update even when they hold the zero value.
+		ForceSendFields []string `json:"-"`
+
+		// Field names, e.g. CustomId, sent as null on create and update, clearing their values.
+		NullFields []string `json:"-"`
 	}
 
 	// CustomersRoot it's a structure of API result list.
 	CustomersRoot struct {
 		// List of customers.
 		Customers []*Customer `json:"data"`
+
+		// Path of the next page, empty on the last page.
+		Next string `json:"next,omitempty"`
+
+		// Cursor of the next page.
+		Offset string `json:"offset,omitempty"`
 	}
 
 	// ListCustomersOptions stores the options you can set for requesting the customer list.
@@ -93,9 +112,19 @@ type (
 )
 
 // CreateWithContext creates a new customer.
-func (c *CustomersService) CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *http.Response, error) {
+func (c *CustomersService) CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *Response, error) {
+	ctx = withOperation(ctx, "Customers", "Create", "consumer", "")
+
 	resource, _ := url.Parse(customersResourcePath)
 
+	if err := c.client.require(ctx, customer.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.client.validate(customer, false); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := c.client.NewRequest(ctx, http.MethodPost, resource, customer)
 
 	if err != nil {
@@ -104,7 +133,7 @@ func (c *CustomersService) CreateWithContext(ctx context.Context, customer *Cust
 
 	root := new(Customer)
 
-	res, err := c.client.Do(req, root)
+	res, err := c.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -114,12 +143,14 @@ func (c *CustomersService) CreateWithContext(ctx context.Context, customer *Cust
 }
 
 // Create creates a new customer.
-func (c *CustomersService) Create(customer *Customer) (*Customer, *http.Response, error) {
+func (c *CustomersService) Create(customer *Customer) (*Customer, *Response, error) {
 	return c.CreateWithContext(context.TODO(), customer)
 }
 
 // DeleteWithContext retrieves registered customer by ID or Username.
-func (c *CustomersService) DeleteWithContext(ctx context.Context, idOrUsername string) (*http.Response, error) {
+func (c *CustomersService) DeleteWithContext(ctx context.Context, idOrUsername string) (*Response, error) {
+	ctx = withOperation(ctx, "Customers", "Delete", "consumer", idOrUsername)
+
 	resource, _ := url.Parse(customersResourcePath)
 	resource.Path = path.Join(resource.Path, idOrUsername)
 
@@ -129,16 +160,18 @@ func (c *CustomersService) DeleteWithContext(ctx context.Context, idOrUsername s
 		return nil, err
 	}
 
-	return c.client.Do(req, nil)
+	return c.client.send(req, nil)
 }
 
 // Delete retrieves registered customer by ID or Username.
-func (c *CustomersService) Delete(idOrUsername string) (*http.Response, error) {
+func (c *CustomersService) Delete(idOrUsername string) (*Response, error) {
 	return c.DeleteWithContext(context.TODO(), idOrUsername)
 }
 
 // GetWithContext retrieves registered customer by ID or Username.
-func (c *CustomersService) GetWithContext(ctx context.Context, idOrUsername string) (*Customer, *http.Response, error) {
+func (c *CustomersService) GetWithContext(ctx context.Context, idOrUsername string) (*Customer, *Response, error) {
+	ctx = withOperation(ctx, "Customers", "Get", "consumer", idOrUsername)
+
 	resource, _ := url.Parse(customersResourcePath)
 	resource.Path = path.Join(resource.Path, idOrUsername)
 
@@ -150,7 +183,7 @@ func (c *CustomersService) GetWithContext(ctx context.Context, idOrUsername stri
 
 	customer := new(Customer)
 
-	res, err := c.client.Do(req, customer)
+	res, err := c.client.send(req, customer)
 
 	if err != nil {
 		return nil, res, err
@@ -160,12 +193,14 @@ func (c *CustomersService) GetWithContext(ctx context.Context, idOrUsername stri
 }
 
 // Get retrieves registered customer by ID or Username.
-func (c *CustomersService) Get(idOrUsername string) (*Customer, *http.Response, error) {
+func (c *CustomersService) Get(idOrUsername string) (*Customer, *Response, error) {
 	return c.GetWithContext(context.TODO(), idOrUsername)
 }
 
 // ListWithContext retrieves a list of registered customers.
-func (c *CustomersService) ListWithContext(ctx context.Context, options *ListCustomersOptions) ([]*Customer, *http.Response, error) {
+func (c *CustomersService) ListWithContext(ctx context.Context, options *ListCustomersOptions) ([]*Customer, *Response, error) {
+	ctx = withOperation(ctx, "Customers", "List", "consumer", "")
+
 	opts, _ := query.Values(options)
 	resource, _ := url.Parse(customersResourcePath)
 	resource.RawQuery = opts.Encode()
@@ -178,7 +213,7 @@ func (c *CustomersService) ListWithContext(ctx context.Context, options *ListCus
 
 	root := new(CustomersRoot)
 
-	res, err := c.client.Do(req, root)
+	res, err := c.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -188,15 +223,25 @@ func (c *CustomersService) ListWithContext(ctx context.Context, options *ListCus
 }
 
 // List retrieves a list of registered customers.
-func (c *CustomersService) List(options *ListCustomersOptions) ([]*Customer, *http.Response, error) {
+func (c *CustomersService) List(options *ListCustomersOptions) ([]*Customer, *Response, error) {
 	return c.ListWithContext(context.TODO(), options)
 }
 
 // UpdateWithContext updates a customer registered by ID or Username.
-func (c *CustomersService) UpdateWithContext(ctx context.Context, idOrUsername string, customer *Customer) (*Customer, *http.Response, error) {
+func (c *CustomersService) UpdateWithContext(ctx context.Context, idOrUsername string, customer *Customer) (*Customer, *Response, error) {
+	ctx = withOperation(ctx, "Customers", "Update", "consumer", idOrUsername)
+
 	resource, _ := url.Parse(customersResourcePath)
 	resource.Path = path.Join(resource.Path, idOrUsername)
 
+	if err := c.client.require(ctx, customer.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.client.validate(customer, true); err != nil {
+		return nil, nil, err
+	}
+
 	req, err := c.client.NewRequest(ctx, http.MethodPatch, resource, customer)
 
 	if err != nil {
@@ -205,7 +250,7 @@ func (c *CustomersService) UpdateWithContext(ctx context.Context, idOrUsername s
 
 	root := new(Customer)
 
-	res, err := c.client.Do(req, root)
+	res, err := c.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -215,6 +260,58 @@ func (c *CustomersService) UpdateWithContext(ctx context.Context, idOrUsername s
 }
 
 // Update updates a customer registered by ID or Username.
-func (c *CustomersService) Update(idOrUsername string, customer *Customer) (*Customer, *http.Response, error) {
+func (c *CustomersService) Update(idOrUsername string, customer *Customer) (*Customer, *Response, error) {
 	return c.UpdateWithContext(context.TODO(), idOrUsername, customer)
 }
+
+// capabilities returns the Kong capabilities needed by the customer attributes.
+func (c *Customer) capabilities() []Capability {
+	if c == nil || len(c.Tags) == 0 {
+		return nil
+	}
+
+	return []Capability{CapabilityTags}
+}
+
+// UnmarshalJSON unmarshals the customer keeping the fields that are not mapped.
+func (c *Customer) UnmarshalJSON(data []byte) error {
+	type customer Customer
+
+	if err := json.Unmarshal(data, (*customer)(c)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, c)
+
+	if err != nil {
+		return err
+	}
+
+	c.Extras = extras
+
+	return nil
+}
+
+// MarshalJSON marshals the customer including the fields that are not mapped.
+func (c Customer) MarshalJSON() ([]byte, error) {
+	type customer Customer
+
+	data, err := json.Marshal(customer(c))
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = mergeExtras(data, c.Extras)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return applyFieldMasks(data, c, c.ForceSendFields, c.NullFields)
+}
+
+// page returns the next page cursor.
+func (c *CustomersRoot) page() (string, string) {
+	return c.Next, c.Offset
+}
@@ -25,7 +25,7 @@ func (s *CustomersTestSuite) TestCreateReturnsHttpError() {
 
 	_, res, err := s.client.Customers.Create(customer)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -50,7 +50,7 @@ func (s *CustomersTestSuite) TestCreate() {
 	customer, res, err := s.client.Customers.Create(payload)
 
 	s.assert.IsType(&Customer{}, customer)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(customer.Id)
@@ -69,7 +69,7 @@ func (s *CustomersTestSuite) TestListReturnsHttpError() {
 	client, _ := New(nil, s.server.URL)
 	_, res, err := client.Customers.List(nil)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -87,7 +87,7 @@ func (s *CustomersTestSuite) TestList() {
 	customers, res, err := s.client.Customers.List(nil)
 
 	s.assert.IsType(&Customer{}, customers[0])
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(customers)
@@ -125,7 +125,7 @@ func (s *CustomersTestSuite) TestListWithOptions() {
 	customers, res, err := s.client.Customers.List(options)
 
 	s.assert.NotZero(customers)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
@@ -140,7 +140,7 @@ func (s *CustomersTestSuite) TestGetReturnsHttpError() {
 
 	_, res, err := s.client.Customers.Get("test-example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -160,7 +160,7 @@ func (s *CustomersTestSuite) TestGet() {
 	customer, res, err := s.client.Customers.Get("2962c1d6b0e6")
 
 	s.assert.IsType(&Customer{}, customer)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(customer.CreatedAt.Unix())
@@ -182,7 +182,7 @@ func (s *CustomersTestSuite) TestUpdateReturnsHttpError() {
 
 	_, res, err := s.client.Customers.Update("example", customer)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -206,7 +206,7 @@ func (s *CustomersTestSuite) TestUpdate() {
 	customer, res, err := s.client.Customers.Update("2962c1d6b0e6", payload)
 
 	s.assert.IsType(&Customer{}, customer)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(customer.Id)
@@ -224,7 +224,7 @@ func (s *CustomersTestSuite) TestDeleteReturnsHttpError() {
 
 	res, err := s.client.Customers.Delete("example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -237,7 +237,7 @@ func (s *CustomersTestSuite) TestDelete() {
 
 	res, err := s.client.Customers.Delete("2962c1d6b0e6")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
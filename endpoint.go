@@ -0,0 +1,277 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// EndpointPool spreads the API calls across several Kong admin nodes sharing the same database.
+	// Reads are balanced between the healthy nodes and calls fail over to the next node on connection
+	// errors or 5xx responses. POST and PATCH calls are not idempotent, so they only fail over when the
+	// node could not be dialed and nothing was sent.
+	EndpointPool struct {
+		endpoints []*endpoint
+
+		// Round robin counter used to balance the reads.
+		counter uint64
+	}
+
+	// EndpointStatus it's the health of an admin node.
+	EndpointStatus struct {
+		// The admin node URL.
+		URL *url.URL
+
+		// Whether the last health check or call succeeded.
+		Healthy bool
+	}
+
+	// endpoint it's an admin node of the pool.
+	endpoint struct {
+		url *url.URL
+
+		mu      sync.RWMutex
+		healthy bool
+	}
+
+	// endpointContextKey it's the context key used to pin a request to an endpoint.
+	endpointContextKey struct{}
+)
+
+// NewEndpointPool returns a pool of the given admin URLs, all of them considered healthy. The path of the
+// URLs, if any, replaces the base path of the client.
+func NewEndpointPool(baseURLs ...string) (*EndpointPool, error) {
+	if len(baseURLs) == 0 {
+		return nil, errors.New("Empty URL is not allowed")
+	}
+
+	p := &EndpointPool{}
+
+	for _, baseURL := range baseURLs {
+		if baseURL == "" {
+			return nil, errors.New("Empty URL is not allowed")
+		}
+
+		parsedURL, err := url.Parse(baseURL)
+
+		if err != nil {
+			return nil, err
+		}
+
+		p.endpoints = append(p.endpoints, &endpoint{url: parsedURL, healthy: true})
+	}
+
+	return p, nil
+}
+
+// Endpoints returns the status of every admin node in the pool.
+func (p *EndpointPool) Endpoints() []EndpointStatus {
+	status := make([]EndpointStatus, len(p.endpoints))
+
+	for i, e := range p.endpoints {
+		status[i] = EndpointStatus{URL: e.url, Healthy: e.isHealthy()}
+	}
+
+	return status
+}
+
+// Interceptor returns an interceptor that sends every request to a healthy admin node.
+func (p *EndpointPool) Interceptor() Interceptor {
+	return func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		if e, ok := req.Context().Value(endpointContextKey{}).(*endpoint); ok {
+			return next(rewriteRequest(req, e.url), value)
+		}
+
+		var (
+			res *http.Response
+			err error
+		)
+
+		for attempt, e := range p.candidates(req.Method) {
+			r := rewriteRequest(req, e.url)
+
+			if attempt > 0 {
+				r = r.WithContext(withRetryAttempt(r.Context(), attempt))
+
+				if req.GetBody != nil {
+					if r.Body, err = req.GetBody(); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			res, err = next(r, value)
+
+			if req.Context().Err() != nil {
+				return res, err
+			}
+
+			if !isNodeFailure(err) {
+				e.setHealthy(true)
+
+				return res, err
+			}
+
+			e.setHealthy(false)
+
+			if !canFailover(req.Method, err) {
+				return res, err
+			}
+		}
+
+		return res, err
+	}
+}
+
+// Check probes the status of every admin node, a node is healthy when it is serving and its database
+// is reachable. The version of the healthy nodes is recorded too, see Kongo.ServerVersion.
+func (p *EndpointPool) Check(ctx context.Context, client *Kongo) {
+	var wg sync.WaitGroup
+
+	for _, e := range p.endpoints {
+		wg.Add(1)
+
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			ctx := context.WithValue(ctx, endpointContextKey{}, e)
+
+			status, _, err := client.Node.StatusWithContext(ctx)
+
+			healthy := err == nil && status.Database != nil && status.Database.Reachable
+
+			e.setHealthy(healthy)
+
+			if healthy {
+				client.Node.InfoWithContext(ctx)
+			}
+		}(e)
+	}
+
+	wg.Wait()
+}
+
+// Watch probes the admin nodes right away and then on every interval until the context is done.
+func (p *EndpointPool) Watch(ctx context.Context, client *Kongo, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	p.Check(ctx, client)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.Check(ctx, client)
+		}
+	}
+}
+
+// candidates returns the endpoints in the order they must be tried. Reads start on the next node of
+// the round robin and writes on the first node, healthy nodes always come first.
+func (p *EndpointPool) candidates(method string) []*endpoint {
+	start := 0
+
+	if method == http.MethodGet || method == http.MethodHead {
+		start = int(atomic.AddUint64(&p.counter, 1)-1) % len(p.endpoints)
+	}
+
+	healthy := make([]*endpoint, 0, len(p.endpoints))
+	unhealthy := make([]*endpoint, 0)
+
+	for i := range p.endpoints {
+		e := p.endpoints[(start+i)%len(p.endpoints)]
+
+		if e.isHealthy() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+// isHealthy reports whether the endpoint is healthy.
+func (e *endpoint) isHealthy() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.healthy
+}
+
+// setHealthy updates the endpoint health.
+func (e *endpoint) setHealthy(healthy bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.healthy = healthy
+}
+
+// ResponseEndpoint returns the admin node URL that served the response.
+func ResponseEndpoint(res *http.Response) *url.URL {
+	if res == nil || res.Request == nil {
+		return nil
+	}
+
+	return &url.URL{Scheme: res.Request.URL.Scheme, Host: res.Request.URL.Host}
+}
+
+// rewriteRequest returns a copy of the request targeting the endpoint. The endpoint path, e.g. /admin-api,
+// replaces the base path of the client when set.
+func rewriteRequest(req *http.Request, endpoint *url.URL) *http.Request {
+	r := req.Clone(req.Context())
+	r.URL.Scheme = endpoint.Scheme
+	r.URL.Host = endpoint.Host
+	r.Host = ""
+
+	if prefix := strings.TrimRight(endpoint.Path, "/"); prefix != "" {
+		basePath, _ := req.Context().Value(basePathContextKey{}).(string)
+
+		r.URL.Path = prefix + strings.TrimPrefix(r.URL.Path, basePath)
+		r.URL.RawPath = ""
+	}
+
+	return r
+}
+
+// canFailover reports whether the failed request can be sent to another node. POST and PATCH requests
+// are only resent when the node could not be dialed.
+func canFailover(method string, err error) bool {
+	if method != http.MethodPost && method != http.MethodPatch {
+		return true
+	}
+
+	var opError *net.OpError
+
+	return errors.As(err, &opError) && opError.Op == "dial"
+}
+
+// isNodeFailure reports whether the error is a connection error or a 5xx response.
+func isNodeFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var urlError *url.Error
+
+	if errors.As(err, &urlError) {
+		return true
+	}
+
+	var errorResponse *ErrorResponse
+
+	if errors.As(err, &errorResponse) {
+		return errorResponse.Response.StatusCode >= http.StatusInternalServerError
+	}
+
+	return false
+}
@@ -0,0 +1,253 @@
+package kongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type EndpointPoolTestSuite struct {
+	BaseTestSuite
+
+	secondMux    *http.ServeMux
+	secondServer *httptest.Server
+	pool         *EndpointPool
+}
+
+func (s *EndpointPoolTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	s.secondMux = http.NewServeMux()
+	s.secondServer = httptest.NewServer(s.secondMux)
+
+	s.pool, _ = NewEndpointPool(s.server.URL, s.secondServer.URL)
+	s.client.Use(s.pool.Interceptor())
+}
+
+func (s *EndpointPoolTestSuite) TearDownTest() {
+	s.BaseTestSuite.TearDownTest()
+	s.secondServer.Close()
+}
+
+func (s *EndpointPoolTestSuite) TestFactoryWithoutURL() {
+	_, err := NewEndpointPool()
+
+	s.assert.EqualError(err, "Empty URL is not allowed")
+
+	_, err = NewEndpointPool("http://127.0.0.1:8001", "")
+
+	s.assert.EqualError(err, "Empty URL is not allowed")
+
+	_, err = NewEndpointPool("http://192.168.1.%1/")
+
+	s.assert.Error(err)
+}
+
+func (s *EndpointPoolTestSuite) TestReadsAreBalanced() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "first"}`)
+	})
+
+	s.secondMux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "second"}`)
+	})
+
+	first, res, _ := s.client.Services.Get("foo")
+
+	s.assert.Equal("first", first.Id)
+	s.assert.Equal(s.server.URL, ResponseEndpoint(res.Response).String())
+
+	second, res, _ := s.client.Services.Get("foo")
+
+	s.assert.Equal("second", second.Id)
+	s.assert.Equal(s.secondServer.URL, ResponseEndpoint(res.Response).String())
+}
+
+func (s *EndpointPoolTestSuite) TestFailoverOnServerError() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	s.secondMux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "name": "foo"}`)
+	})
+
+	svc, res, err := s.client.Services.Get("foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+	s.assert.Equal(s.secondServer.URL, ResponseEndpoint(res.Response).String())
+
+	endpoints := s.pool.Endpoints()
+
+	s.assert.False(endpoints[0].Healthy)
+	s.assert.True(endpoints[1].Healthy)
+}
+
+func (s *EndpointPoolTestSuite) TestPostIsNotFailedOverOnServerError() {
+	s.mux.HandleFunc(servicesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	s.secondMux.HandleFunc(servicesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("request must not fail over")
+	})
+
+	_, res, err := s.client.Services.Create(&Service{Name: "foo", Host: "example.com"})
+
+	s.assert.EqualError(err, "500 Request error")
+	s.assert.Equal(s.server.URL, ResponseEndpoint(res.Response).String())
+	s.assert.False(s.pool.Endpoints()[0].Healthy)
+}
+
+func (s *EndpointPoolTestSuite) TestPostFailoverOnDialError() {
+	s.secondMux.HandleFunc(servicesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal("foo", body["name"])
+
+		fmt.Fprint(w, `{"id": "1", "name": "foo"}`)
+	})
+
+	s.server.Close()
+
+	svc, res, err := s.client.Services.Create(&Service{Name: "foo", Host: "example.com"})
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+	s.assert.Equal(s.secondServer.URL, ResponseEndpoint(res.Response).String())
+}
+
+func (s *EndpointPoolTestSuite) TestEndpointPathPrefix() {
+	pool, _ := NewEndpointPool(s.server.URL + "/admin-api/")
+	client, _ := New(nil, s.server.URL)
+	client.Use(pool.Interceptor())
+
+	s.mux.HandleFunc("/admin-api/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	svc, _, err := client.Services.Get("foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+}
+
+func (s *EndpointPoolTestSuite) TestEndpointPathReplacesBasePath() {
+	pool, _ := NewEndpointPool(s.server.URL + "/admin-api")
+	client, _ := New(nil, s.server.URL+"/admin-api")
+	client.Use(pool.Interceptor())
+
+	s.mux.HandleFunc("/admin-api/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	status, _, err := client.Node.Status()
+
+	s.assert.Nil(err)
+	s.assert.True(status.Database.Reachable)
+
+	pool, _ = NewEndpointPool(s.server.URL + "/kong")
+	client, _ = NewWithOptions(s.server.URL, WithBasePath("/admin-api"))
+	client.Use(pool.Interceptor())
+
+	s.mux.HandleFunc("/kong/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": false}}`)
+	})
+
+	status, _, err = client.Node.Status()
+
+	s.assert.Nil(err)
+	s.assert.False(status.Database.Reachable)
+}
+
+func (s *EndpointPoolTestSuite) TestFailoverOnConnectionError() {
+	s.secondMux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.server.Close()
+
+	status, res, err := s.client.Node.Status()
+
+	s.assert.Nil(err)
+	s.assert.True(status.Database.Reachable)
+	s.assert.Equal(s.secondServer.URL, ResponseEndpoint(res.Response).String())
+}
+
+func (s *EndpointPoolTestSuite) TestClientErrorIsNotFailedOver() {
+	s.mux.HandleFunc("/routes/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	s.secondMux.HandleFunc("/routes/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("request must not fail over")
+	})
+
+	_, err := s.client.Routes.Delete("foo")
+
+	s.assert.EqualError(err, "404 Request error")
+}
+
+func (s *EndpointPoolTestSuite) TestCheck() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": false}}`)
+	})
+
+	s.secondMux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.pool.Check(context.Background(), s.client)
+
+	endpoints := s.pool.Endpoints()
+
+	s.assert.False(endpoints[0].Healthy)
+	s.assert.True(endpoints[1].Healthy)
+
+	s.secondMux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "second"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		svc, _, _ := s.client.Services.Get("foo")
+
+		s.assert.Equal("second", svc.Id)
+	}
+}
+
+func (s *EndpointPoolTestSuite) TestWatchChecksRightAway() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": false}}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		s.pool.Watch(ctx, s.client, time.Hour)
+		close(done)
+	}()
+
+	s.assert.Eventually(func() bool {
+		return !s.pool.Endpoints()[0].Healthy
+	}, time.Second, 5*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func (s *EndpointPoolTestSuite) TestResponseEndpointWithoutResponse() {
+	s.assert.Nil(ResponseEndpoint(nil))
+}
+
+func TestEndpointPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(EndpointPoolTestSuite))
+}
@@ -0,0 +1,105 @@
+package kongo
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFieldsCache stores the json keys by struct type.
+var knownFieldsCache sync.Map
+
+// knownFields returns the json keys of the struct type fields.
+func knownFields(t reflect.Type) map[string]bool {
+	if fields, ok := knownFieldsCache.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.TrimSpace(strings.Split(field.Tag.Get("json"), ",")[0])
+
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = true
+	}
+
+	knownFieldsCache.Store(t, fields)
+
+	return fields
+}
+
+// unknownFields returns the JSON object fields that are not mapped by the struct pointed by value.
+func unknownFields(data []byte, value interface{}) (map[string]json.RawMessage, error) {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(reflect.TypeOf(value).Elem())
+
+	for key := range raw {
+		if known[key] {
+			delete(raw, key)
+		}
+	}
+
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	return raw, nil
+}
+
+// mergeExtras adds the extra fields to the JSON object, the mapped fields take precedence.
+func mergeExtras(data []byte, extras map[string]json.RawMessage) ([]byte, error) {
+	if len(extras) == 0 {
+		return data, nil
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for key, value := range extras {
+		if _, ok := object[key]; !ok {
+			object[key] = value
+		}
+	}
+
+	return json.Marshal(object)
+}
+
+// mergeExtrasMap adds the extra fields to a body built by sheriff, the mapped fields take precedence.
+// The masked fields, when given, override any value.
+func mergeExtrasMap(body interface{}, extras map[string]json.RawMessage, masked map[string]json.RawMessage) interface{} {
+	object, ok := body.(map[string]interface{})
+
+	if !ok {
+		return body
+	}
+
+	for key, value := range extras {
+		if _, ok := object[key]; !ok {
+			object[key] = value
+		}
+	}
+
+	for key, value := range masked {
+		object[key] = value
+	}
+
+	return object
+}
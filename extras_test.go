@@ -0,0 +1,106 @@
+package kongo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type ExtrasTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *ExtrasTestSuite) TestRouteRoundTrip() {
+	route := new(Route)
+
+	err := json.Unmarshal([]byte(`{"id": "1", "paths": ["/api"], "regex_priority": 10, "https_redirect_status_code": 426}`), route)
+
+	s.assert.Nil(err)
+	s.assert.Equal([]string{"/api"}, route.Paths)
+	s.assert.Equal(json.RawMessage(`10`), route.Extras["regex_priority"])
+	s.assert.Len(route.Extras, 2)
+
+	data, _ := json.Marshal(route)
+
+	var object map[string]interface{}
+
+	json.Unmarshal(data, &object)
+
+	s.assert.Equal(float64(10), object["regex_priority"])
+	s.assert.Equal(float64(426), object["https_redirect_status_code"])
+	s.assert.Equal("1", object["id"])
+}
+
+func (s *ExtrasTestSuite) TestMappedFieldsTakePrecedence() {
+	customer := &Customer{
+		Username: "foo",
+		Extras:   map[string]json.RawMessage{"username": json.RawMessage(`"bar"`), "type": json.RawMessage(`0`)},
+	}
+
+	data, _ := json.Marshal(customer)
+
+	var object map[string]interface{}
+
+	json.Unmarshal(data, &object)
+
+	s.assert.Equal("foo", object["username"])
+	s.assert.Equal(float64(0), object["type"])
+}
+
+func (s *ExtrasTestSuite) TestWithoutExtras() {
+	customer := new(Customer)
+
+	s.assert.Nil(json.Unmarshal([]byte(`{"id": "1", "username": "foo"}`), customer))
+	s.assert.Nil(customer.Extras)
+	s.assert.Error(json.Unmarshal([]byte(`{"id": 1}`), customer))
+	s.assert.Error(json.Unmarshal([]byte(`{"id": 1}`), new(Service)))
+	s.assert.Error(json.Unmarshal([]byte(`{"id": 1}`), new(Route)))
+	s.assert.Error(json.Unmarshal([]byte(`{"database": 1}`), new(NodeInfoConfiguration)))
+}
+
+func (s *ExtrasTestSuite) TestServiceGetThenUpdate() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, `{"id": "1", "name": "foo", "host": "example.com", "protocol": "http", "tls_verify": true}`)
+
+			return
+		}
+
+		var body map[string]interface{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal(http.MethodPatch, r.Method)
+		s.assert.Equal("example.org", body["host"])
+		s.assert.Equal(true, body["tls_verify"])
+		s.assert.NotContains(body, "id")
+
+		fmt.Fprint(w, `{"id": "1", "name": "foo", "host": "example.org", "protocol": "http", "tls_verify": true}`)
+	})
+
+	svc, _, _ := s.client.Services.Get("foo")
+	svc.Host = "example.org"
+
+	updated, _, err := s.client.Services.Update("foo", svc)
+
+	s.assert.Nil(err)
+	s.assert.Equal(json.RawMessage(`true`), updated.Extras["tls_verify"])
+}
+
+func (s *ExtrasTestSuite) TestNodeInfoConfigurationExtras() {
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"configuration": {"database": "postgres", "router_flavor": "expressions"}}`)
+	})
+
+	info, _, err := s.client.Node.Info()
+
+	s.assert.Nil(err)
+	s.assert.Equal("postgres", info.Configuration.Database)
+	s.assert.Equal(json.RawMessage(`"expressions"`), info.Configuration.Extras["router_flavor"])
+}
+
+func TestExtrasTestSuite(t *testing.T) {
+	suite.Run(t, new(ExtrasTestSuite))
+}
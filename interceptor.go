@@ -0,0 +1,61 @@
+package kongo
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// Handler sends an API request and unmarshals the response body into value.
+	Handler func(req *http.Request, value interface{}) (*http.Response, error)
+
+	// Interceptor wraps every API call made through Kongo.Do. It receives the outgoing request and the
+	// next handler of the chain; after calling next, value holds the parsed response and the returned error
+	// is an *ErrorResponse when the API replied with a non 2xx status. An interceptor can short-circuit the
+	// chain by returning without calling next.
+	Interceptor func(req *http.Request, value interface{}, next Handler) (*http.Response, error)
+
+	// interceptorsContextKey it's the context key used to store the interceptors of a call.
+	interceptorsContextKey struct{}
+)
+
+// Use appends interceptors to the client chain. Interceptors are executed in the order they were added,
+// the first one being the outermost.
+func (k *Kongo) Use(interceptors ...Interceptor) {
+	k.interceptors = append(k.interceptors, interceptors...)
+}
+
+// ContextWithInterceptors returns a copy of the context carrying interceptors for the calls made with it,
+// replacing the ones it already carries. They run before the client interceptors, the first one being the
+// outermost, so the client interceptors see every request they send, e.g. retries, and leave the client
+// untouched. The requests the client sends on its own, e.g. the circuit
+// breaker probe or the server version lookup, don't run them.
+func ContextWithInterceptors(ctx context.Context, interceptors ...Interceptor) context.Context {
+	return context.WithValue(ctx, interceptorsContextKey{}, interceptors)
+}
+
+// withoutCallInterceptors returns a copy of the context dropping the interceptors of the call, so the options
+// of a call, e.g. a workspace, don't leak into the requests the client sends on its own.
+func withoutCallInterceptors(ctx context.Context) context.Context {
+	return ContextWithInterceptors(ctx)
+}
+
+// chain builds the handler that runs the interceptors carried by the context, then the registered ones,
+// around the given handler.
+func (k *Kongo) chain(ctx context.Context, handler Handler) Handler {
+	callInterceptors, _ := ctx.Value(interceptorsContextKey{}).([]Interceptor)
+
+	interceptors := make([]Interceptor, 0, len(callInterceptors)+len(k.interceptors))
+	interceptors = append(interceptors, callInterceptors...)
+	interceptors = append(interceptors, k.interceptors...)
+
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+
+		handler = func(req *http.Request, value interface{}) (*http.Response, error) {
+			return interceptor(req, value, next)
+		}
+	}
+
+	return handler
+}
@@ -0,0 +1,141 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+type InterceptorTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *InterceptorTestSuite) TestInterceptorsRunInOrder() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("abc", r.Header.Get("X-Correlation-ID"))
+
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	calls := []string{}
+
+	s.client.Use(
+		func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+			calls = append(calls, "first")
+			req.Header.Set("X-Correlation-ID", "abc")
+
+			res, err := next(req, value)
+
+			calls = append(calls, "first done")
+
+			return res, err
+		},
+		func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+			calls = append(calls, "second")
+
+			res, err := next(req, value)
+
+			s.assert.True(value.(*NodeStatus).Database.Reachable)
+
+			return res, err
+		},
+	)
+
+	status, res, err := s.client.Node.Status()
+
+	s.assert.NotNil(res)
+	s.assert.Nil(err)
+	s.assert.True(status.Database.Reachable)
+	s.assert.Equal([]string{"first", "second", "first done"}, calls)
+}
+
+func (s *InterceptorTestSuite) TestInterceptorReceivesErrorResponse() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		fmt.Fprint(w, `{"message": "An unexpected error occurred"}`)
+	})
+
+	var errorResponse *ErrorResponse
+
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		res, err := next(req, value)
+
+		errorResponse, _ = err.(*ErrorResponse)
+
+		return res, err
+	})
+
+	resource, _ := url.Parse("/status")
+	req, _ := s.client.NewRequest(context.TODO(), http.MethodGet, resource, nil)
+	_, err := s.client.Do(req, nil)
+
+	s.assert.Error(err)
+	s.assert.NotNil(errorResponse)
+	s.assert.Equal(http.StatusInternalServerError, errorResponse.Response.StatusCode)
+	s.assert.Equal("An unexpected error occurred", errorResponse.Message)
+}
+
+func (s *InterceptorTestSuite) TestInterceptorShortCircuit() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("request must not reach the server")
+	})
+
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		return nil, errors.New("blocked")
+	})
+
+	_, res, err := s.client.Node.Status()
+
+	s.assert.Nil(res)
+	s.assert.EqualError(err, "blocked")
+}
+
+func (s *InterceptorTestSuite) TestContextInterceptors() {
+	var headers []string
+
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header.Values("X-Call")
+
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	calls := []string{}
+
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		calls = append(calls, "client")
+
+		return next(req, value)
+	})
+
+	interceptor := func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		calls = append(calls, "call")
+		req.Header.Add("X-Call", "call")
+
+		return next(req, value)
+	}
+
+	ctx := ContextWithInterceptors(context.Background(), interceptor)
+	ctx = ContextWithInterceptors(ctx, interceptor)
+
+	_, _, err := s.client.Node.StatusWithContext(ctx)
+
+	s.assert.Nil(err)
+	s.assert.Equal([]string{"call", "client"}, calls)
+	s.assert.Equal([]string{"call"}, headers)
+
+	calls = []string{}
+
+	s.client.Node.Status()
+
+	s.assert.Equal([]string{"client"}, calls)
+	s.assert.Empty(headers)
+}
+
+func TestInterceptorTestSuite(t *testing.T) {
+	suite.Run(t, new(InterceptorTestSuite))
+}
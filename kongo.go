@@ -6,16 +6,19 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 const (
-	version   = "v0"
+	version   = "v1"
 	userAgent = "kongo/" + version
 	mediaType = "application/json"
 )
@@ -33,6 +36,9 @@ type (
 		// User agent for client
 		UserAgent string
 
+		// Validates the entities on create and update before sending them
+		ValidateEntities bool
+
 		// Node api service
 		Node Node
 
@@ -44,6 +50,25 @@ type (
 
 		// Customers api service
 		Customers Customers
+
+		// Schemas api service
+		Schemas Schemas
+
+		// Interceptors executed around every API call
+		interceptors []Interceptor
+
+		// Kong server versions by admin node, recorded from the node information responses
+		versions  map[string]*Version
+		versionMu sync.Mutex
+
+		// Headers added to every request
+		headers http.Header
+
+		// Timeout of every request, zero for none
+		timeout time.Duration
+
+		// Path prefix of the Admin API, e.g. /admin-api
+		basePath string
 	}
 
 	// An ErrorResponse report the error caused by and API request
@@ -53,15 +78,27 @@ type (
 
 		// Error message based on http status code
 		Message string `json:"message, omitempty"`
+
+		// Kong error code, e.g. 5 for unique constraint violation
+		Code int `json:"code,omitempty"`
+
+		// Kong error name, e.g. unique constraint violation
+		Name string `json:"name,omitempty"`
+
+		// Invalid fields of schema violations by field name, nested for records
+		Fields map[string]interface{} `json:"fields,omitempty"`
 	}
 
 	// Time it is a custom time struct for json parsing
 	Time struct {
 		time.Time
 	}
+
+	// basePathContextKey it's the context key used to store the base path prefixing the request path.
+	basePathContextKey struct{}
 )
 
-// NewClient returns a new Kongo API client.
+// NewClient returns a new Kongo API client. The base URL must be absolute, its path being the base path.
 func NewClient(client *http.Client, baseURL *url.URL) (*Kongo, error) {
 	if client == nil {
 		client = http.DefaultClient
@@ -71,22 +108,24 @@ func NewClient(client *http.Client, baseURL *url.URL) (*Kongo, error) {
 		return nil, errors.New("Empty URL is not allowed")
 	}
 
+	if err := validateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+
 	k := &Kongo{client: client, BaseURL: baseURL, UserAgent: userAgent}
+	k.basePath = strings.TrimRight(baseURL.Path, "/")
 	k.Node = &NodeService{k}
 	k.Services = &ServicesService{k}
 	k.Routes = &RoutesService{k}
 	k.Customers = &CustomersService{k}
+	k.Schemas = &SchemasService{k}
 
 	return k, nil
 }
 
-// New returns a new Kongo API client.
+// New returns a new Kongo API client. The base URL must be absolute, e.g. http://127.0.0.1:8001.
 func New(client *http.Client, baseURL string) (*Kongo, error) {
-	if baseURL == "" {
-		return nil, errors.New("Empty URL is not allowed")
-	}
-
-	parsedURL, err := url.Parse(baseURL)
+	parsedURL, err := parseBaseURL(baseURL)
 
 	if err != nil {
 		return nil, err
@@ -95,9 +134,21 @@ func New(client *http.Client, baseURL string) (*Kongo, error) {
 	return NewClient(client, parsedURL)
 }
 
+// BasePath returns the prefix of the resource paths, empty when the resources are served from the root.
+func (k *Kongo) BasePath() string {
+	return k.basePath
+}
+
 // NewRequest creates an API requrest. A relative URL can be provided in res URL instance. If specified, the
 // value pointed to by body JSON encoded and included in as the request body.
 func (k *Kongo) NewRequest(ctx context.Context, method string, res *url.URL, body interface{}) (*http.Request, error) {
+	if k.basePath != "" && strings.HasPrefix(res.Path, "/") {
+		prefixed := *res
+		prefixed.Path = path.Join(k.basePath, res.Path)
+		prefixed.RawPath = ""
+		res = &prefixed
+	}
+
 	url := k.BaseURL.ResolveReference(res)
 
 	buf := new(bytes.Buffer)
@@ -116,36 +167,84 @@ func (k *Kongo) NewRequest(ctx context.Context, method string, res *url.URL, bod
 		return nil, err
 	}
 
+	if k.basePath != "" {
+		ctx = context.WithValue(ctx, basePathContextKey{}, k.basePath)
+	}
+
 	req = req.WithContext(ctx)
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
-	req.Header.Add("User-Agent", userAgent)
+
+	for key, values := range k.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if k.UserAgent != "" {
+		req.Header.Set("User-Agent", k.UserAgent)
+	} else {
+		req.Header.Set("User-Agent", userAgent)
+	}
 
 	return req, nil
 }
 
 // Do sends an API request and returns the API response. If the HTTP response is in the 2xx range,
-// unmarshal the response body into value.
+// unmarshal the response body into value, or copy it when value is an io.Writer. No content responses
+// leave value untouched. The request passes through the interceptors carried by its context, then the
+// registered ones.
 func (k *Kongo) Do(req *http.Request, value interface{}) (*http.Response, error) {
+	return k.chain(req.Context(), k.do)(req, value)
+}
+
+// do sends an API request through the HTTP client and unmarshals the response.
+func (k *Kongo) do(req *http.Request, value interface{}) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+
+	if k.timeout > 0 {
+		var ctx context.Context
+
+		ctx, cancel = context.WithTimeout(req.Context(), k.timeout)
+		req = req.WithContext(ctx)
+	}
+
 	res, err := k.client.Do(req)
 
 	if err != nil {
+		cancel()
+
 		return nil, err
 	}
 
-	defer res.Body.Close()
-
 	err = k.checkResponse(res)
 
 	if err != nil {
+		res.Body.Close()
+		cancel()
+
 		return res, err
 	}
 
-	if value == nil {
+	if stream, ok := value.(*streamBody); ok {
+		stream.body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+
+		return res, nil
+	}
+
+	defer cancel()
+
+	defer res.Body.Close()
+
+	if value == nil || res.StatusCode == http.StatusNoContent {
 		return res, nil
 	}
 
-	err = json.NewDecoder(res.Body).Decode(value)
+	if w, ok := value.(io.Writer); ok {
+		_, err = io.Copy(w, res.Body)
+	} else {
+		err = json.NewDecoder(res.Body).Decode(value)
+	}
 
 	if err != nil {
 		return nil, err
@@ -194,23 +293,97 @@ func (e *ErrorResponse) Error() string {
 	)
 }
 
-// UnmarshalJSON unmarshals string time into Time instance
+// UnmarshalJSON unmarshals a Kong timestamp into Time instance. Seconds, milliseconds, microseconds and
+// fractional seconds are detected by the value, empty, null and 0 values are the zero time.
 func (t *Time) UnmarshalJSON(value []byte) (err error) {
 	v := strings.Trim(string(value), "\"")
 
-	if v == "" {
+	if v == "" || v == "null" {
 		t.Time = time.Time{}
 
 		return
 	}
 
-	timestamp, err := strconv.ParseInt(v, 10, 64)
+	seconds, fraction := v, ""
+
+	if i := strings.Index(v, "."); i >= 0 {
+		seconds, fraction = v[:i], v[i+1:]
+	}
+
+	timestamp, err := strconv.ParseInt(seconds, 10, 64)
 
 	if err != nil {
 		return
 	}
 
-	t.Time = time.Unix(timestamp, 0)
+	if fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+
+		nanos, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(seconds, "-") {
+			nanos = -nanos
+		}
+
+		t.Time = time.Unix(timestamp, nanos)
+	} else {
+		switch abs := timestampAbs(timestamp); {
+		case abs >= 1e17:
+			t.Time = time.Unix(0, timestamp)
+		case abs >= 1e14:
+			t.Time = time.UnixMicro(timestamp)
+		case abs >= 1e11:
+			t.Time = time.UnixMilli(timestamp)
+		default:
+			t.Time = time.Unix(timestamp, 0)
+		}
+	}
+
+	// the epoch is the zero time, so it round-trips as null
+	if t.Time.Equal(time.Unix(0, 0)) {
+		t.Time = time.Time{}
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the Time instance into Kong timestamp format, seconds since epoch with the
+// fractional part when present. The zero time is marshalled as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	seconds, nanos, sign := t.Unix(), t.Nanosecond(), ""
+
+	if seconds < 0 {
+		seconds, nanos, sign = -seconds, -nanos, "-"
+
+		if nanos < 0 {
+			seconds, nanos = seconds-1, nanos+1e9
+		}
+	}
+
+	v := sign + strconv.FormatInt(seconds, 10)
+
+	if nanos != 0 {
+		v += "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	}
+
+	return []byte(v), nil
+}
+
+// timestampAbs returns the absolute value of the timestamp.
+func timestampAbs(timestamp int64) int64 {
+	if timestamp < 0 {
+		return -timestamp
+	}
 
-	return
+	return timestamp
 }
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 type (
@@ -66,6 +67,18 @@ func (s *BaseTestSuite) LoadFixture(filePath string) (io.ReadCloser, error) {
 	return file, nil
 }
 
+func (s *BaseTestSuite) nodeInfo() *NodeInfo {
+	file, _ := s.LoadFixture("fixtures/node_info_payload.json")
+
+	defer file.Close()
+
+	info := new(NodeInfo)
+
+	json.NewDecoder(file).Decode(info)
+
+	return info
+}
+
 func (s *KongoTestSuite) TestFactoryClientWithEmptyURL() {
 	_, err := NewClient(nil, nil)
 
@@ -84,6 +97,45 @@ func (s *KongoTestSuite) TestFactoryWithInvalidURL() {
 	s.assert.Error(err)
 }
 
+func (s *KongoTestSuite) TestFactoryWithoutScheme() {
+	_, err := New(nil, "127.0.0.1:8001")
+
+	s.assert.EqualError(err, "URL must have a scheme, e.g. http://127.0.0.1:8001")
+}
+
+func (s *KongoTestSuite) TestFactoryClientWithRelativeURL() {
+	_, err := NewClient(nil, &url.URL{Host: "127.0.0.1:8001"})
+
+	s.assert.EqualError(err, "URL scheme must be http or https")
+
+	_, err = NewClient(nil, &url.URL{Scheme: "http"})
+
+	s.assert.EqualError(err, "URL must have a host")
+}
+
+func (s *KongoTestSuite) TestFactoryWithCredentials() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+
+		s.assert.True(ok)
+		s.assert.Equal("admin", username)
+		s.assert.Equal("secret", password)
+
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	serverURL, _ := url.Parse(s.server.URL)
+	serverURL.User = url.UserPassword("admin", "secret")
+
+	client, err := New(nil, serverURL.String())
+
+	s.assert.Nil(err)
+
+	_, _, err = client.Node.Status()
+
+	s.assert.Nil(err)
+}
+
 func (s *KongoTestSuite) TestInstance() {
 	s.assert.IsType(new(Kongo), s.client)
 	s.assert.Equal(userAgent, s.client.UserAgent)
@@ -91,6 +143,7 @@ func (s *KongoTestSuite) TestInstance() {
 	s.assert.Implements(new(Services), s.client.Services)
 	s.assert.Implements(new(Routes), s.client.Routes)
 	s.assert.Implements(new(Customers), s.client.Customers)
+	s.assert.Implements(new(Schemas), s.client.Schemas)
 }
 
 func (s *KongoTestSuite) TestCreateRequestWithInvalidMethod() {
@@ -114,6 +167,15 @@ func (s *KongoTestSuite) TestCreateRequest() {
 	s.assert.Equal(userAgent, req.Header.Get("User-Agent"))
 }
 
+func (s *KongoTestSuite) TestCreateRequestWithUserAgent() {
+	s.client.UserAgent = "deployer/1.0"
+
+	resource, _ := url.Parse("/status")
+	req, _ := s.client.NewRequest(context.TODO(), http.MethodGet, resource, nil)
+
+	s.assert.Equal("deployer/1.0", req.Header.Get("User-Agent"))
+}
+
 func (s *KongoTestSuite) TestCallApiWithoutRequestUrl() {
 	req, _ := http.NewRequest(http.MethodGet, "", nil)
 	res, err := s.client.Do(req, nil)
@@ -294,6 +356,143 @@ func (s *KongoTestSuite) TestJSONTimeParsing() {
 	s.assert.Equal("2018-04-04", data.Created.Format("2006-01-02"))
 }
 
+func (s *KongoTestSuite) TestJSONTimeParsingWithNull() {
+	var data MockData
+
+	err := json.Unmarshal(
+		[]byte(`{"created": null}`),
+		&data,
+	)
+
+	s.assert.Nil(err)
+	s.assert.True(data.Created.IsZero())
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingWithZero() {
+	for _, raw := range []string{`0`, `"0"`, `0.0`} {
+		var data MockData
+
+		err := json.Unmarshal([]byte(`{"created": `+raw+`}`), &data)
+
+		s.assert.Nil(err, raw)
+		s.assert.True(data.Created.IsZero(), raw)
+
+		encoded, _ := json.Marshal(data)
+
+		s.assert.JSONEq(`{"created": null}`, string(encoded), raw)
+	}
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingNumericSeconds() {
+	var data MockData
+
+	json.Unmarshal(
+		[]byte(`{"created": 1522832400}`),
+		&data,
+	)
+
+	s.assert.Equal(int64(1522832400), data.Created.Unix())
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingMilliseconds() {
+	var data MockData
+
+	json.Unmarshal(
+		[]byte(`{"created": 1522832400123}`),
+		&data,
+	)
+
+	s.assert.Equal("2018-04-04", data.Created.UTC().Format("2006-01-02"))
+	s.assert.Equal(123*time.Millisecond, time.Duration(data.Created.Nanosecond()))
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingFixtureMilliseconds() {
+	file, _ := s.LoadFixture("fixtures/routes_payload.json")
+
+	defer file.Close()
+
+	route := new(Route)
+
+	json.NewDecoder(file).Decode(route)
+
+	s.assert.True(time.UnixMilli(14888869056483).Equal(route.CreatedAt.Time))
+	s.assert.Equal(2441, route.CreatedAt.UTC().Year())
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingMicroseconds() {
+	var data MockData
+
+	json.Unmarshal(
+		[]byte(`{"created": 1522832400123456}`),
+		&data,
+	)
+
+	s.assert.Equal("2018-04-04", data.Created.UTC().Format("2006-01-02"))
+	s.assert.Equal(123456*time.Microsecond, time.Duration(data.Created.Nanosecond()))
+}
+
+func (s *KongoTestSuite) TestJSONTimeParsingFractionalSeconds() {
+	var data MockData
+
+	json.Unmarshal(
+		[]byte(`{"created": 1522832400.5}`),
+		&data,
+	)
+
+	s.assert.Equal(int64(1522832400), data.Created.Unix())
+	s.assert.Equal(500*time.Millisecond, time.Duration(data.Created.Nanosecond()))
+
+	err := json.Unmarshal(
+		[]byte(`{"created": "1522832400.x"}`),
+		&data,
+	)
+
+	s.assert.Error(err)
+}
+
+func (s *KongoTestSuite) TestJSONTimeMarshalling() {
+	data, _ := json.Marshal(MockData{Created: Time{time.Unix(1522832400, 0)}})
+
+	s.assert.JSONEq(`{"created": 1522832400}`, string(data))
+
+	data, _ = json.Marshal(MockData{Created: Time{time.Unix(1522832400, 250000000)}})
+
+	s.assert.JSONEq(`{"created": 1522832400.25}`, string(data))
+
+	data, _ = json.Marshal(MockData{})
+
+	s.assert.JSONEq(`{"created": null}`, string(data))
+}
+
+func (s *KongoTestSuite) TestJSONTimeRoundTrip() {
+	var data MockData
+
+	json.Unmarshal([]byte(`{"created": 1522832400.123}`), &data)
+
+	encoded, _ := json.Marshal(data)
+
+	s.assert.JSONEq(`{"created": 1522832400.123}`, string(encoded))
+}
+
+func (s *KongoTestSuite) TestJSONTimeNegativeFractionalSeconds() {
+	for raw, expected := range map[string]time.Duration{
+		"-1.5": -1500 * time.Millisecond,
+		"-0.5": -500 * time.Millisecond,
+		"-2":   -2 * time.Second,
+	} {
+		var data MockData
+
+		err := json.Unmarshal([]byte(`{"created": `+raw+`}`), &data)
+
+		s.assert.Nil(err)
+		s.assert.Equal(expected, data.Created.Sub(time.Unix(0, 0)), raw)
+
+		encoded, _ := json.Marshal(data)
+
+		s.assert.JSONEq(`{"created": `+raw+`}`, string(encoded), raw)
+	}
+}
+
 func TestKongoTestSuite(t *testing.T) {
 	suite.Run(t, new(KongoTestSuite))
 }
@@ -0,0 +1,150 @@
+package kongo
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	redactedValue = "[REDACTED]"
+)
+
+type (
+	// LoggerOptions stores the options you can set for logging the Admin API traffic.
+	LoggerOptions struct {
+		// Level used to log the successful calls. Failed calls are always logged as error.
+		Level slog.Level
+
+		// Logs the request and response bodies, credential fields are redacted.
+		LogBodies bool
+
+		// Body fields that will be redacted, defaults to the credential fields of Kong plugins.
+		RedactFields []string
+	}
+)
+
+// defaultRedactFields are the credential fields of key-auth, basic-auth, jwt and oauth2 plugins.
+var defaultRedactFields = []string{"key", "password", "secret", "client_secret"}
+
+// LoggingInterceptor returns an interceptor that logs every API call using the given logger.
+func LoggingInterceptor(logger *slog.Logger, options *LoggerOptions) Interceptor {
+	if options == nil {
+		options = &LoggerOptions{}
+	}
+
+	fields := options.RedactFields
+
+	if fields == nil {
+		fields = defaultRedactFields
+	}
+
+	redact := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+		redact[field] = true
+	}
+
+	return func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("path", req.URL.Path),
+		}
+
+		if options.LogBodies {
+			if body := requestBody(req); len(body) > 0 {
+				attrs = append(attrs, slog.String("request_body", redactBody(body, redact)))
+			}
+		}
+
+		start := time.Now()
+		res, err := next(req, value)
+
+		attrs = append(attrs, slog.Duration("latency", time.Since(start)))
+
+		if res != nil {
+			attrs = append(
+				attrs,
+				slog.Int("status", res.StatusCode),
+				slog.String("kong_latency", res.Header.Get("X-Kong-Admin-Latency")),
+			)
+		}
+
+		if options.LogBodies && err == nil && value != nil && !isRawValue(value) {
+			if body, e := json.Marshal(value); e == nil {
+				attrs = append(attrs, slog.String("response_body", redactBody(body, redact)))
+			}
+		}
+
+		level := options.Level
+
+		if err != nil {
+			level = slog.LevelError
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		logger.LogAttrs(req.Context(), level, "kongo request", attrs...)
+
+		return res, err
+	}
+}
+
+// requestBody reads the request body without consuming it.
+func requestBody(req *http.Request) []byte {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(req.Body)
+
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return nil
+	}
+
+	return bytes.TrimSpace(data)
+}
+
+// redactBody replaces the values of the redacted fields in a JSON body.
+func redactBody(body []byte, fields map[string]bool) string {
+	var data interface{}
+
+	if err := json.Unmarshal(body, &data); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(data, fields))
+
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+// redactValue walks through the decoded JSON value redacting the fields.
+func redactValue(value interface{}, fields map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if fields[key] {
+				v[key] = redactedValue
+
+				continue
+			}
+
+			v[key] = redactValue(item, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, fields)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,115 @@
+package kongo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net/http"
+	"testing"
+)
+
+type LoggerTestSuite struct {
+	BaseTestSuite
+
+	output *bytes.Buffer
+	logger *slog.Logger
+}
+
+func (s *LoggerTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	s.output = new(bytes.Buffer)
+	s.logger = slog.New(slog.NewJSONHandler(s.output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func (s *LoggerTestSuite) entry() map[string]interface{} {
+	var entry map[string]interface{}
+
+	json.Unmarshal(s.output.Bytes(), &entry)
+
+	return entry
+}
+
+func (s *LoggerTestSuite) TestLogRequest() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Kong-Admin-Latency", "3")
+
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.client.Use(LoggingInterceptor(s.logger, nil))
+	s.client.Node.Status()
+
+	entry := s.entry()
+
+	s.assert.Equal("INFO", entry["level"])
+	s.assert.Equal(http.MethodGet, entry["method"])
+	s.assert.Equal(nodeStatusResourcePath, entry["path"])
+	s.assert.Equal(float64(http.StatusOK), entry["status"])
+	s.assert.Equal("3", entry["kong_latency"])
+	s.assert.Contains(entry, "latency")
+	s.assert.NotContains(entry, "response_body")
+}
+
+func (s *LoggerTestSuite) TestLogFailedRequest() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	s.client.Use(LoggingInterceptor(s.logger, &LoggerOptions{Level: slog.LevelDebug}))
+	s.client.Node.Status()
+
+	entry := s.entry()
+
+	s.assert.Equal("ERROR", entry["level"])
+	s.assert.Equal(float64(http.StatusInternalServerError), entry["status"])
+	s.assert.Equal("500 Request error", entry["error"])
+}
+
+func (s *LoggerTestSuite) TestLogBodiesWithRedaction() {
+	s.mux.HandleFunc("/consumers/foo/key-auth", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "key": "server-key", "consumer": {"id": "2"}}`)
+	})
+
+	s.client.Use(LoggingInterceptor(s.logger, &LoggerOptions{LogBodies: true}))
+
+	body := map[string]interface{}{
+		"key":   "client-key",
+		"oauth": []interface{}{map[string]interface{}{"client_secret": "abc", "name": "app"}},
+	}
+
+	resource, _ := s.client.BaseURL.Parse("/consumers/foo/key-auth")
+	req, _ := s.client.NewRequest(context.TODO(), http.MethodPost, resource, body)
+	value := map[string]interface{}{}
+
+	_, err := s.client.Do(req, &value)
+
+	s.assert.Nil(err)
+	s.assert.Equal("server-key", value["key"])
+
+	entry := s.entry()
+
+	s.assert.JSONEq(
+		`{"key": "[REDACTED]", "oauth": [{"client_secret": "[REDACTED]", "name": "app"}]}`,
+		entry["request_body"].(string),
+	)
+	s.assert.JSONEq(
+		`{"id": "1", "key": "[REDACTED]", "consumer": {"id": "2"}}`,
+		entry["response_body"].(string),
+	)
+}
+
+func (s *LoggerTestSuite) TestRedactCustomFields() {
+	s.assert.JSONEq(
+		`{"key": "k", "token": "[REDACTED]"}`,
+		redactBody([]byte(`{"key": "k", "token": "t"}`), map[string]bool{"token": true}),
+	)
+	s.assert.Equal("not json", redactBody([]byte("not json"), nil))
+}
+
+func TestLoggerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggerTestSuite))
+}
@@ -0,0 +1,107 @@
+package kongo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldMaskValues returns the JSON values of the force send fields and the null fields, keyed by json
+// key. Fields are referenced by their Go name, e.g. StripPath. When group is not empty, the fields out of
+// the sheriff group are ignored.
+func fieldMaskValues(entity interface{}, forceSendFields, nullFields []string, group string) (map[string]json.RawMessage, error) {
+	values := map[string]json.RawMessage{}
+
+	if len(forceSendFields) == 0 && len(nullFields) == 0 {
+		return values, nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(entity))
+
+	lookup := func(name string) (reflect.StructField, string, bool, error) {
+		field, ok := v.Type().FieldByName(name)
+
+		if !ok {
+			return field, "", false, fmt.Errorf("Unknown field %s", name)
+		}
+
+		key := strings.TrimSpace(strings.Split(field.Tag.Get("json"), ",")[0])
+
+		if key == "" || key == "-" {
+			return field, "", false, fmt.Errorf("Field %s is not sent to the API", name)
+		}
+
+		if group != "" && !inGroup(field, group) {
+			return field, key, false, nil
+		}
+
+		return field, key, true, nil
+	}
+
+	for _, name := range forceSendFields {
+		field, key, ok, err := lookup(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		data, err := json.Marshal(v.FieldByIndex(field.Index).Interface())
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[key] = data
+	}
+
+	for _, name := range nullFields {
+		_, key, ok, err := lookup(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			values[key] = json.RawMessage("null")
+		}
+	}
+
+	return values, nil
+}
+
+// applyFieldMasks sets the force send fields and the null fields on the JSON object.
+func applyFieldMasks(data []byte, entity interface{}, forceSendFields, nullFields []string) ([]byte, error) {
+	values, err := fieldMaskValues(entity, forceSendFields, nullFields, "")
+
+	if err != nil || len(values) == 0 {
+		return data, err
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for key, value := range values {
+		object[key] = value
+	}
+
+	return json.Marshal(object)
+}
+
+// inGroup reports whether the field belongs to the sheriff group.
+func inGroup(field reflect.StructField, group string) bool {
+	for _, g := range strings.Split(field.Tag.Get("groups"), ",") {
+		if g == group {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package kongo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type FieldMaskTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *FieldMaskTestSuite) body(path string) *map[string]interface{} {
+	body := map[string]interface{}{}
+
+	s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPatch, r.Method)
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	return &body
+}
+
+func (s *FieldMaskTestSuite) TestRouteUpdate() {
+	body := s.body("/routes/1")
+
+	_, _, err := s.client.Routes.Update("1", &Route{
+		Paths:           []string{"/api"},
+		ForceSendFields: []string{"StripPath", "PreserveHost"},
+		NullFields:      []string{"Hosts"},
+	})
+
+	s.assert.Nil(err)
+	s.assert.Equal(false, (*body)["strip_path"])
+	s.assert.Equal(false, (*body)["preserve_host"])
+	s.assert.Contains(*body, "hosts")
+	s.assert.Nil((*body)["hosts"])
+	s.assert.Equal([]interface{}{"/api"}, (*body)["paths"])
+}
+
+func (s *FieldMaskTestSuite) TestServiceUpdate() {
+	body := s.body("/services/foo")
+
+	_, _, err := s.client.Services.Update("foo", &Service{
+		Host:            "example.com",
+		ForceSendFields: []string{"Retries", "Id"},
+		NullFields:      []string{"Path"},
+	})
+
+	s.assert.Nil(err)
+	s.assert.Equal(float64(0), (*body)["retries"])
+	s.assert.Contains(*body, "path")
+	s.assert.Nil((*body)["path"])
+	s.assert.NotContains(*body, "id")
+}
+
+func (s *FieldMaskTestSuite) TestServiceUpdateByURLIgnoresFieldsOutOfGroup() {
+	body := s.body("/services/foo")
+
+	_, _, err := s.client.Services.UpdateByURL("foo", &Service{
+		URL:             "http://example.com",
+		ForceSendFields: []string{"Retries"},
+	})
+
+	s.assert.Nil(err)
+	s.assert.NotContains(*body, "retries")
+	s.assert.Equal("http://example.com", (*body)["url"])
+}
+
+func (s *FieldMaskTestSuite) TestCustomerUpdate() {
+	body := s.body("/customers/foo")
+
+	_, _, err := s.client.Customers.Update("foo", &Customer{Username: "foo", NullFields: []string{"CustomId"}})
+
+	s.assert.Nil(err)
+	s.assert.Contains(*body, "custom_id")
+	s.assert.Nil((*body)["custom_id"])
+}
+
+func (s *FieldMaskTestSuite) TestUnknownFields() {
+	_, _, err := s.client.Services.Update("foo", &Service{ForceSendFields: []string{"Unknown"}})
+
+	s.assert.EqualError(err, "Unknown field Unknown")
+
+	_, _, err = s.client.Routes.Update("1", &Route{NullFields: []string{"Extras"}})
+
+	s.assert.Error(err)
+}
+
+func TestFieldMaskTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldMaskTestSuite))
+}
@@ -2,29 +2,37 @@ package kongo
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 const (
 	nodeInfoResourcePath   = "/"
 	nodeStatusResourcePath = "/status"
+	nodeReadyResourcePath  = "/status/ready"
 )
 
 type (
 	// Node retrieves the info about the server nodes.
 	Node interface {
 		// Info retrieves the information about the server node
-		Info() (*NodeInfo, *http.Response, error)
+		Info() (*NodeInfo, *Response, error)
 
 		// InfoWithContext retrieves the information about the server node
-		InfoWithContext(ctx context.Context) (*NodeInfo, *http.Response, error)
+		InfoWithContext(ctx context.Context) (*NodeInfo, *Response, error)
 
 		// Status retrieves the status of the server node.
-		Status() (*NodeStatus, *http.Response, error)
+		Status() (*NodeStatus, *Response, error)
 
 		// StatusWithContext retrieves the status of the server node.
-		StatusWithContext(ctx context.Context) (*NodeStatus, *http.Response, error)
+		StatusWithContext(ctx context.Context) (*NodeStatus, *Response, error)
+
+		// WaitReady polls the server node until it is serving and the database is reachable.
+		WaitReady(ctx context.Context, options *WaitReadyOptions) error
 	}
 
 	// NodeService it's a concrete instance of node
@@ -119,7 +127,8 @@ type (
 		NginxPID             string `json:"nginx_pid"`
 		NginxWorkerProcesses string `json:"nginx_worker_processes"`
 
-		Plugins map[string]bool `json:"plugins"`
+		// A map of flags before Kong 1.0, a list, e.g. ["bundled"], since then.
+		Plugins interface{} `json:"plugins"`
 
 		PostgresDatabase  string `json:"pg_database"`
 		PostgresHost      string `json:"pg_host"`
@@ -141,17 +150,21 @@ type (
 
 		ServerTokens bool `json:"server_tokens"`
 
-		SSLCertificate           string `json:"ssl_cert"`
-		SSLCertificateDefault    string `json:"ssl_cert_default"`
-		SSLCertificateKey        string `json:"ssl_cert_key"`
-		SSLCertificateDefaultKey string `json:"ssl_cert_key_default"`
-		SSLCertificateCsrDefault string `json:"ssl_cert_csr_default"`
-		SSLCiphers               string `json:"ssl_ciphers"`
-		SSLCipherSuite           string `json:"ssl_cipher_suite"`
+		// A path before Kong 2.x, a list of paths since then.
+		SSLCertificate           interface{} `json:"ssl_cert"`
+		SSLCertificateDefault    string      `json:"ssl_cert_default"`
+		SSLCertificateKey        interface{} `json:"ssl_cert_key"`
+		SSLCertificateDefaultKey string      `json:"ssl_cert_key_default"`
+		SSLCertificateCsrDefault string      `json:"ssl_cert_csr_default"`
+		SSLCiphers               string      `json:"ssl_ciphers"`
+		SSLCipherSuite           string      `json:"ssl_cipher_suite"`
 
 		TrustedIps interface{} `json:"trusted_ips"`
 
 		UpstreamKeepAlive int `json:"upstream_keepalive"`
+
+		// Configuration keys that are not mapped yet.
+		Extras map[string]json.RawMessage `json:"-"`
 	}
 
 	// NodeInfoListener it's a structure of API result
@@ -166,8 +179,16 @@ type (
 
 	// NodeInfoPlugins it's a structure of API result
 	NodeInfoPlugins struct {
-		AvailableOnServer map[string]bool `json:"available_on_server"`
-		EnabledInCluster  []string        `json:"enabled_in_cluster"`
+		AvailableOnServer map[string]NodeInfoAvailablePlugin `json:"available_on_server"`
+		EnabledInCluster  []string                           `json:"enabled_in_cluster"`
+	}
+
+	// NodeInfoAvailablePlugin it's a plugin available on the server node, flagged before Kong 3.0 and described
+	// by its priority and version since then.
+	NodeInfoAvailablePlugin struct {
+		Available bool   `json:"-"`
+		Priority  int    `json:"priority"`
+		Version   string `json:"version"`
 	}
 
 	// NodeInfoTimers it's a structure of API result
@@ -187,6 +208,27 @@ type (
 		Reachable bool `json:"reachable, omitempty"`
 	}
 
+	// WaitReadyOptions stores the options you can set for waiting the server node.
+	WaitReadyOptions struct {
+		// Initial interval between the polls, doubled after each attempt. Defaults to 500 milliseconds.
+		Interval time.Duration
+
+		// Maximum interval between the polls. Defaults to 5 seconds.
+		MaxInterval time.Duration
+	}
+
+	// NotReadyError reports the conditions that never became true while waiting the server node.
+	NotReadyError struct {
+		// Conditions not satisfied by the last poll.
+		Conditions []string
+
+		// Last error returned by the status requests.
+		LastError error
+
+		// Error of the expired context.
+		ContextError error
+	}
+
 	// NodeStatusServer it's a structure of API result
 	NodeStatusServer struct {
 		ConnectionsAccepted int `json:"connections_accepted, omitempty"`
@@ -200,7 +242,9 @@ type (
 )
 
 // InfoWithContext retrieves the server node information
-func (n *NodeService) InfoWithContext(ctx context.Context) (*NodeInfo, *http.Response, error) {
+func (n *NodeService) InfoWithContext(ctx context.Context) (*NodeInfo, *Response, error) {
+	ctx = withOperation(ctx, "Node", "Info", "node", "")
+
 	resource, _ := url.Parse(nodeInfoResourcePath)
 
 	req, err := n.client.NewRequest(ctx, http.MethodGet, resource, nil)
@@ -211,22 +255,26 @@ func (n *NodeService) InfoWithContext(ctx context.Context) (*NodeInfo, *http.Res
 
 	nodeInfo := new(NodeInfo)
 
-	res, err := n.client.Do(req, nodeInfo)
+	res, err := n.client.send(req, nodeInfo)
 
 	if err != nil {
 		return nil, res, err
 	}
 
+	n.client.recordVersion(res, nodeInfo.Version)
+
 	return nodeInfo, res, nil
 }
 
 // Info retrieves the server node information
-func (n *NodeService) Info() (*NodeInfo, *http.Response, error) {
+func (n *NodeService) Info() (*NodeInfo, *Response, error) {
 	return n.InfoWithContext(context.TODO())
 }
 
 // StatusWithContext retrieves the server node status.
-func (n *NodeService) StatusWithContext(ctx context.Context) (*NodeStatus, *http.Response, error) {
+func (n *NodeService) StatusWithContext(ctx context.Context) (*NodeStatus, *Response, error) {
+	ctx = withOperation(ctx, "Node", "Status", "node", "")
+
 	resource, _ := url.Parse(nodeStatusResourcePath)
 
 	req, err := n.client.NewRequest(ctx, http.MethodGet, resource, nil)
@@ -237,7 +285,7 @@ func (n *NodeService) StatusWithContext(ctx context.Context) (*NodeStatus, *http
 
 	nodeStatus := new(NodeStatus)
 
-	res, err := n.client.Do(req, nodeStatus)
+	res, err := n.client.send(req, nodeStatus)
 
 	if err != nil {
 		return nil, res, err
@@ -247,6 +295,156 @@ func (n *NodeService) StatusWithContext(ctx context.Context) (*NodeStatus, *http
 }
 
 // Status retrieves the server node status.
-func (n *NodeService) Status() (*NodeStatus, *http.Response, error) {
+func (n *NodeService) Status() (*NodeStatus, *Response, error) {
 	return n.StatusWithContext(context.TODO())
 }
+
+// WaitReady polls the server node status, and the readiness endpoint on Kong versions supporting it, with
+// exponential backoff until the node is serving and the database is reachable or the context expires.
+func (n *NodeService) WaitReady(ctx context.Context, options *WaitReadyOptions) error {
+	opts := WaitReadyOptions{}
+
+	if options != nil {
+		opts = *options
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = 500 * time.Millisecond
+	}
+
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 5 * time.Second
+	}
+
+	interval := opts.Interval
+	notReady := &NotReadyError{}
+
+	for {
+		conditions, err := n.readiness(ctx)
+
+		if len(conditions) == 0 {
+			return nil
+		}
+
+		// a poll interrupted by the context says nothing about the node, keep the previous diagnostic
+		if ctx.Err() == nil || len(notReady.Conditions) == 0 {
+			notReady.Conditions, notReady.LastError = conditions, err
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			notReady.ContextError = ctx.Err()
+
+			return notReady
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// readiness returns the readiness conditions not satisfied by the server node.
+func (n *NodeService) readiness(ctx context.Context) ([]string, error) {
+	status, _, err := n.StatusWithContext(ctx)
+
+	if err != nil {
+		return []string{"node is serving", "database is reachable"}, err
+	}
+
+	if status.Database == nil || !status.Database.Reachable {
+		return []string{"database is reachable"}, nil
+	}
+
+	supported, err := n.client.Supports(ctx, CapabilityReadiness)
+
+	// without the version the readiness endpoint may be skipped on a node exposing it
+	if err != nil {
+		return []string{"node version is known"}, err
+	}
+
+	if !supported {
+		return nil, nil
+	}
+
+	resource, _ := url.Parse(nodeReadyResourcePath)
+
+	req, err := n.client.NewRequest(withOperation(ctx, "Node", "WaitReady", "node", ""), http.MethodGet, resource, nil)
+
+	if err != nil {
+		return []string{"node is ready"}, err
+	}
+
+	if _, err := n.client.Do(req, nil); err != nil {
+		return []string{"node is ready"}, err
+	}
+
+	return nil, nil
+}
+
+// Error retrieves the conditions that never became true.
+func (e *NotReadyError) Error() string {
+	msg := fmt.Sprintf("Node is not ready: %s", strings.Join(e.Conditions, ", "))
+
+	if e.LastError != nil {
+		msg = fmt.Sprintf("%s (last error: %s)", msg, e.LastError)
+	}
+
+	return msg
+}
+
+// Unwrap returns the error of the expired context.
+func (e *NotReadyError) Unwrap() error {
+	return e.ContextError
+}
+
+// UnmarshalJSON unmarshals both the flag and the object of the plugin.
+func (p *NodeInfoAvailablePlugin) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &p.Available); err == nil {
+		return nil
+	}
+
+	type plugin NodeInfoAvailablePlugin
+
+	if err := json.Unmarshal(data, (*plugin)(p)); err != nil {
+		return err
+	}
+
+	p.Available = true
+
+	return nil
+}
+
+// MarshalJSON marshals the plugin as a flag unless its priority or version is known.
+func (p NodeInfoAvailablePlugin) MarshalJSON() ([]byte, error) {
+	if p.Priority == 0 && p.Version == "" {
+		return json.Marshal(p.Available)
+	}
+
+	type plugin NodeInfoAvailablePlugin
+
+	return json.Marshal(plugin(p))
+}
+
+// UnmarshalJSON unmarshals the configuration keeping the fields that are not mapped
+func (c *NodeInfoConfiguration) UnmarshalJSON(data []byte) error {
+	type configuration NodeInfoConfiguration
+
+	if err := json.Unmarshal(data, (*configuration)(c)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, c)
+
+	if err != nil {
+		return err
+	}
+
+	c.Extras = extras
+
+	return nil
+}
@@ -1,11 +1,15 @@
 package kongo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/stretchr/testify/suite"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 )
 
 type NodeTestSuite struct {
@@ -24,7 +28,7 @@ func (s *NodeTestSuite) TestInfoReturnsHttpError() {
 	client, _ := New(nil, s.server.URL)
 	_, res, err := client.Node.Info()
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -42,7 +46,7 @@ func (s *NodeTestSuite) TestInfo() {
 	info, res, err := s.client.Node.Info()
 
 	s.assert.IsType(&NodeInfo{}, info)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(info.Plugins.AvailableOnServer)
@@ -152,6 +156,45 @@ func (s *NodeTestSuite) TestInfo() {
 	s.assert.NotEmpty(info.Hostname)
 }
 
+func (s *NodeTestSuite) TestInfoKong3() {
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		file, _ := s.LoadFixture("fixtures/node_info_payload_3x.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	info, _, err := s.client.Node.Info()
+
+	s.assert.Nil(err)
+	s.assert.Equal("3.4.0", info.Version)
+	s.assert.Equal(NodeInfoAvailablePlugin{Available: true, Priority: 950, Version: "3.4.0"}, info.Plugins.AvailableOnServer["acl"])
+	s.assert.Equal([]interface{}{"bundled"}, info.Configuration.Plugins)
+	s.assert.Len(info.Configuration.SSLCertificate, 2)
+	s.assert.Equal("intermediate", info.Configuration.SSLCipherSuite)
+	s.assert.Contains(info.Configuration.Extras, "headers")
+}
+
+func (s *NodeTestSuite) TestAvailablePluginJSON() {
+	plugins := map[string]NodeInfoAvailablePlugin{}
+
+	err := json.Unmarshal([]byte(`{"acl": true, "cors": false, "jwt": {"priority": 1450, "version": "3.4.0"}}`), &plugins)
+
+	s.assert.Nil(err)
+	s.assert.Equal(NodeInfoAvailablePlugin{Available: true}, plugins["acl"])
+	s.assert.Equal(NodeInfoAvailablePlugin{}, plugins["cors"])
+	s.assert.Equal(NodeInfoAvailablePlugin{Available: true, Priority: 1450, Version: "3.4.0"}, plugins["jwt"])
+
+	data, _ := json.Marshal(plugins)
+
+	s.assert.JSONEq(`{"acl": true, "cors": false, "jwt": {"priority": 1450, "version": "3.4.0"}}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"acl": "yes"}`), &plugins)
+
+	s.assert.Error(err)
+}
+
 func (s *NodeTestSuite) TestStatusReturnsHttpError() {
 	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
 		s.assert.Equal(http.MethodGet, r.Method)
@@ -164,7 +207,7 @@ func (s *NodeTestSuite) TestStatusReturnsHttpError() {
 	client, _ := New(nil, s.server.URL)
 	_, res, err := client.Node.Status()
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -182,7 +225,7 @@ func (s *NodeTestSuite) TestStatus() {
 	status, res, err := s.client.Node.Status()
 
 	s.assert.IsType(&NodeStatus{}, status)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(status.Server.ConnectionsAccepted)
@@ -196,6 +239,137 @@ func (s *NodeTestSuite) TestStatus() {
 	s.assert.True(status.Database.Reachable)
 }
 
+func (s *NodeTestSuite) TestWaitReady() {
+	polls := 0
+
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+
+		if polls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"database": {"reachable": %t}}`, polls > 2)
+	})
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "3.4.0"}`)
+	})
+
+	s.mux.HandleFunc(nodeReadyResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if polls < 4 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	err := s.client.Node.WaitReady(context.Background(), &WaitReadyOptions{Interval: time.Millisecond})
+
+	s.assert.Nil(err)
+	s.assert.Equal(4, polls)
+}
+
+func (s *NodeTestSuite) TestWaitReadyWithoutReadinessEndpoint() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "3.2.0"}`)
+	})
+
+	s.mux.HandleFunc(nodeReadyResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("readiness endpoint must not be probed before Kong 3.3")
+	})
+
+	s.assert.Nil(s.client.Node.WaitReady(context.Background(), nil))
+}
+
+func (s *NodeTestSuite) TestWaitReadyKong3() {
+	probes := 0
+
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		file, _ := s.LoadFixture("fixtures/node_info_payload_3x.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	s.mux.HandleFunc(nodeReadyResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		probes++
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+
+	defer cancel()
+
+	err := s.client.Node.WaitReady(ctx, &WaitReadyOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond})
+
+	s.assert.IsType(&NotReadyError{}, err)
+	s.assert.Contains(err.Error(), "Node is not ready: node is ready")
+	s.assert.NotZero(probes)
+}
+
+func (s *NodeTestSuite) TestWaitReadyWithUnknownVersion() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+
+	defer cancel()
+
+	err := s.client.Node.WaitReady(ctx, &WaitReadyOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond})
+
+	s.assert.IsType(&NotReadyError{}, err)
+	s.assert.Contains(err.Error(), "Node is not ready: node version is known (last error: ")
+}
+
+func (s *NodeTestSuite) TestWaitReadyTimeout() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": false}}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+
+	defer cancel()
+
+	err := s.client.Node.WaitReady(ctx, &WaitReadyOptions{Interval: time.Millisecond, MaxInterval: 2 * time.Millisecond})
+
+	s.assert.EqualError(err, "Node is not ready: database is reachable")
+	s.assert.True(errors.Is(err, context.DeadlineExceeded))
+}
+
+func (s *NodeTestSuite) TestWaitReadyTimeoutWithStatusError() {
+	s.server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cancel()
+
+	err := s.client.Node.WaitReady(ctx, nil)
+
+	s.assert.IsType(&NotReadyError{}, err)
+	s.assert.Contains(err.Error(), "Node is not ready: node is serving, database is reachable (last error: ")
+	s.assert.True(errors.Is(err, context.Canceled))
+}
+
 func TestNodeTestSuite(t *testing.T) {
 	suite.Run(t, new(NodeTestSuite))
 }
@@ -0,0 +1,63 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Operation describes the logical API operation that originated a request.
+	Operation struct {
+		// The api service name, e.g. Services.
+		Service string
+
+		// The api service method, e.g. Create.
+		Method string
+
+		// The entity type handled by the operation, e.g. service.
+		Entity string
+
+		// The identification of the entity, ID or name, when known.
+		EntityID string
+	}
+
+	// operationContextKey it's the context key used to store the operation.
+	operationContextKey struct{}
+
+	// retryAttemptContextKey it's the context key used to store the retry attempt.
+	retryAttemptContextKey struct{}
+)
+
+// String returns the operation name, e.g. kongo.Services.Create.
+func (o Operation) String() string {
+	return "kongo." + o.Service + "." + o.Method
+}
+
+// OperationFromContext retrieves the operation stored in the request context.
+func OperationFromContext(ctx context.Context) (Operation, bool) {
+	op, ok := ctx.Value(operationContextKey{}).(Operation)
+
+	return op, ok
+}
+
+// withOperation returns a copy of the context with the operation attached.
+func withOperation(ctx context.Context, service, method, entity, entityID string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, Operation{
+		Service:  service,
+		Method:   method,
+		Entity:   entity,
+		EntityID: entityID,
+	})
+}
+
+// withRetryAttempt returns a copy of the context flagging the request as a retry, so other interceptors,
+// e.g. metrics, can tell retries apart.
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptContextKey{}, attempt)
+}
+
+// RetryAttempt retrieves the retry attempt stored in the request context, zero for the first attempt.
+func RetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptContextKey{}).(int)
+
+	return attempt
+}
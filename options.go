@@ -0,0 +1,185 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+type (
+	// Option configures the client built by NewWithOptions.
+	Option func(k *Kongo) error
+
+	// cancelBody it's a response body that releases the request timeout when closed.
+	cancelBody struct {
+		io.ReadCloser
+
+		cancel context.CancelFunc
+	}
+)
+
+// NewWithOptions returns a new Kongo API client. The base URL must be absolute, e.g. http://127.0.0.1:8001,
+// without credentials, query or fragment, its path being the default base path, and the options are
+// validated before the client is returned.
+func NewWithOptions(baseURL string, opts ...Option) (*Kongo, error) {
+	parsedURL, err := parseBaseURL(baseURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedURL.User != nil {
+		return nil, errors.New("URL must not have credentials, use WithHeader instead")
+	}
+
+	if parsedURL.RawQuery != "" || parsedURL.Fragment != "" {
+		return nil, errors.New("URL must not have query or fragment")
+	}
+
+	k, err := NewClient(nil, parsedURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		if err := opt(k); err != nil {
+			return nil, err
+		}
+	}
+
+	return k, nil
+}
+
+// WithHTTPClient sets the HTTP client used to communicate with the Kong Admin API.
+func WithHTTPClient(client *http.Client) Option {
+	return func(k *Kongo) error {
+		if client == nil {
+			return errors.New("Empty HTTP client is not allowed")
+		}
+
+		k.client = client
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the requests.
+func WithUserAgent(userAgent string) Option {
+	return func(k *Kongo) error {
+		if strings.TrimSpace(userAgent) == "" {
+			return errors.New("Empty user agent is not allowed")
+		}
+
+		k.UserAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithHeader adds a header to every request, e.g. Kong-Admin-Token.
+func WithHeader(key, value string) Option {
+	return func(k *Kongo) error {
+		switch http.CanonicalHeaderKey(key) {
+		case "", "Content-Type", "Accept", "User-Agent":
+			return errors.New("Header " + key + " is not allowed")
+		}
+
+		if k.headers == nil {
+			k.headers = http.Header{}
+		}
+
+		k.headers.Add(key, value)
+
+		return nil
+	}
+}
+
+// WithTimeout limits the duration of every request, response body reading included.
+func WithTimeout(timeout time.Duration) Option {
+	return func(k *Kongo) error {
+		if timeout <= 0 {
+			return errors.New("Timeout must be positive")
+		}
+
+		k.timeout = timeout
+
+		return nil
+	}
+}
+
+// WithLogger logs every API call using the given logger, see LoggingInterceptor.
+func WithLogger(logger *slog.Logger) Option {
+	return func(k *Kongo) error {
+		if logger == nil {
+			return errors.New("Empty logger is not allowed")
+		}
+
+		k.Use(LoggingInterceptor(logger, nil))
+
+		return nil
+	}
+}
+
+// WithBasePath prefixes the resource paths, e.g. /admin-api when Kong is served behind a proxy. It is
+// joined to the path of the base URL.
+func WithBasePath(basePath string) Option {
+	return func(k *Kongo) error {
+		if !strings.HasPrefix(basePath, "/") {
+			return errors.New("Base path must start with /")
+		}
+
+		k.basePath = strings.TrimRight(path.Join(k.basePath, basePath), "/")
+
+		return nil
+	}
+}
+
+// parseBaseURL parses and validates the base URL.
+func parseBaseURL(baseURL string) (*url.URL, error) {
+	if baseURL == "" {
+		return nil, errors.New("Empty URL is not allowed")
+	}
+
+	if !strings.Contains(baseURL, "://") {
+		return nil, errors.New("URL must have a scheme, e.g. http://" + baseURL)
+	}
+
+	parsedURL, err := url.Parse(baseURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBaseURL(parsedURL); err != nil {
+		return nil, err
+	}
+
+	return parsedURL, nil
+}
+
+// validateBaseURL rejects relative URLs and the ones whose scheme is not http or https.
+func validateBaseURL(parsedURL *url.URL) error {
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return errors.New("URL scheme must be http or https")
+	}
+
+	if parsedURL.Host == "" {
+		return errors.New("URL must have a host")
+	}
+
+	return nil
+}
+
+// Close closes the body and releases the request timeout.
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,93 @@
+// Package otelkongo traces the kongo Admin API calls with OpenTelemetry.
+package otelkongo
+
+import (
+	"github.com/fabiorphp/kongo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+const (
+	tracerName = "github.com/fabiorphp/kongo/otelkongo"
+)
+
+// Interceptor returns an interceptor that records a span for every API operation, e.g.
+// kongo.Services.Create. The span is a child of the span stored in the request context. When provider
+// is nil the global tracer provider is used.
+func Interceptor(provider trace.TracerProvider) kongo.Interceptor {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	tracer := provider.Tracer(tracerName)
+
+	return func(req *http.Request, value interface{}, next kongo.Handler) (*http.Response, error) {
+		name := "kongo." + req.Method
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", req.URL.Path),
+		}
+
+		if op, ok := kongo.OperationFromContext(req.Context()); ok {
+			name = op.String()
+			attrs = append(attrs, attribute.String("kongo.entity.type", op.Entity))
+
+			if op.EntityID != "" {
+				attrs = append(attrs, attribute.String("kongo.entity.id", op.EntityID))
+			}
+		}
+
+		ctx, span := tracer.Start(
+			req.Context(),
+			name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+
+		defer span.End()
+
+		req = req.WithContext(ctx)
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+		res, err := next(req, value)
+
+		if res != nil {
+			span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
+		}
+
+		if err != nil {
+			if errorResponse, ok := err.(*kongo.ErrorResponse); ok && errorResponse.Code != 0 {
+				span.SetAttributes(attribute.Int("kongo.error.code", errorResponse.Code))
+			}
+
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+
+			return res, err
+		}
+
+		if id := entityID(value); id != "" {
+			span.SetAttributes(attribute.String("kongo.entity.id", id))
+		}
+
+		return res, err
+	}
+}
+
+// entityID returns the identification of a decoded entity.
+func entityID(value interface{}) string {
+	switch v := value.(type) {
+	case *kongo.Service:
+		return v.Id
+	case *kongo.Route:
+		return v.Id
+	case *kongo.Customer:
+		return v.Id
+	}
+
+	return ""
+}
@@ -0,0 +1,127 @@
+package otelkongo
+
+import (
+	"context"
+	"fmt"
+	"github.com/fabiorphp/kongo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type TracingTestSuite struct {
+	suite.Suite
+
+	assert *assert.Assertions
+	client *kongo.Kongo
+
+	mux    *http.ServeMux
+	server *httptest.Server
+
+	exporter *tracetest.InMemoryExporter
+	provider *sdktrace.TracerProvider
+}
+
+func (s *TracingTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.server = httptest.NewServer(s.mux)
+
+	s.client, _ = kongo.New(nil, s.server.URL)
+
+	s.exporter = tracetest.NewInMemoryExporter()
+	s.provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(s.exporter))
+
+	s.client.Use(Interceptor(s.provider))
+
+	s.assert = assert.New(s.T())
+}
+
+func (s *TracingTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *TracingTestSuite) attributes(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+
+	for _, attr := range span.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs
+}
+
+func (s *TracingTestSuite) TestSpanPerOperation() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "4e13f54a-bbf1-47a8-8777-255fed7116f2", "name": "foo"}`)
+	})
+
+	parentCtx, parent := s.provider.Tracer("test").Start(context.Background(), "sync")
+
+	_, _, err := s.client.Services.GetWithContext(parentCtx, "foo")
+
+	parent.End()
+
+	s.assert.Nil(err)
+
+	spans := s.exporter.GetSpans()
+
+	s.assert.Len(spans, 2)
+	s.assert.Equal("kongo.Services.Get", spans[0].Name)
+	s.assert.Equal(parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+
+	attrs := s.attributes(spans[0])
+
+	s.assert.Equal("service", attrs["kongo.entity.type"].AsString())
+	s.assert.Equal("4e13f54a-bbf1-47a8-8777-255fed7116f2", attrs["kongo.entity.id"].AsString())
+	s.assert.Equal(int64(http.StatusOK), attrs["http.response.status_code"].AsInt64())
+}
+
+func (s *TracingTestSuite) TestSpanWithKongError() {
+	s.mux.HandleFunc("/routes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+
+		fmt.Fprint(w, `{"code": 5, "name": "unique constraint violation", "message": "already exists"}`)
+	})
+
+	_, _, err := s.client.Routes.Create(&kongo.Route{})
+
+	s.assert.Error(err)
+
+	spans := s.exporter.GetSpans()
+
+	s.assert.Len(spans, 1)
+	s.assert.Equal("kongo.Routes.Create", spans[0].Name)
+	s.assert.Equal(codes.Error, spans[0].Status.Code)
+
+	attrs := s.attributes(spans[0])
+
+	s.assert.Equal("route", attrs["kongo.entity.type"].AsString())
+	s.assert.Equal(int64(http.StatusConflict), attrs["http.response.status_code"].AsInt64())
+	s.assert.Equal(int64(5), attrs["kongo.error.code"].AsInt64())
+}
+
+func (s *TracingTestSuite) TestSpanWithoutOperation() {
+	s.mux.HandleFunc("/plugins", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	resource, _ := s.client.BaseURL.Parse("/plugins")
+	req, _ := s.client.NewRequest(context.TODO(), http.MethodGet, resource, nil)
+
+	s.client.Do(req, nil)
+
+	spans := s.exporter.GetSpans()
+
+	s.assert.Len(spans, 1)
+	s.assert.Equal("kongo.GET", spans[0].Name)
+}
+
+func TestTracingTestSuite(t *testing.T) {
+	suite.Run(t, new(TracingTestSuite))
+}
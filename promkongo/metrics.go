@@ -0,0 +1,121 @@
+// Package promkongo exposes Prometheus metrics of the kongo Admin API calls and parses the metrics of the
+// Kong prometheus plugin.
+package promkongo
+
+import (
+	"github.com/fabiorphp/kongo"
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	metricsNamespace = "kongo"
+)
+
+type (
+	// Metrics collects Prometheus metrics of the Admin API calls made by the client.
+	Metrics struct {
+		// Number of requests by entity, operation and status code.
+		requests *prometheus.CounterVec
+
+		// Latency of the requests by entity and operation.
+		latency *prometheus.HistogramVec
+
+		// Number of retried requests by entity and operation.
+		retries *prometheus.CounterVec
+
+		// Number of requests waiting for a response.
+		inFlight prometheus.Gauge
+	}
+)
+
+// NewMetrics returns a new metrics collector. When registerer is not nil the collector is registered on it.
+func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_total",
+			Help:      "Number of Admin API requests by entity, operation and status code.",
+		}, []string{"entity", "operation", "code"}),
+
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of the Admin API requests by entity and operation.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"entity", "operation"}),
+
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "request_retries_total",
+			Help:      "Number of retried Admin API requests by entity and operation.",
+		}, []string{"entity", "operation"}),
+
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of Admin API requests waiting for a response.",
+		}),
+	}
+
+	if registerer == nil {
+		return m, nil
+	}
+
+	if err := registerer.Register(m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Describe sends the metrics descriptors to the channel.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.latency.Describe(ch)
+	m.retries.Describe(ch)
+	m.inFlight.Describe(ch)
+}
+
+// Collect sends the collected metrics to the channel.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.latency.Collect(ch)
+	m.retries.Collect(ch)
+	m.inFlight.Collect(ch)
+}
+
+// Interceptor returns an interceptor that records the metrics of every API call.
+func (m *Metrics) Interceptor() kongo.Interceptor {
+	return func(req *http.Request, value interface{}, next kongo.Handler) (*http.Response, error) {
+		entity, operation := "unknown", req.Method
+
+		if op, ok := kongo.OperationFromContext(req.Context()); ok {
+			entity, operation = op.Entity, op.Method
+		}
+
+		if kongo.RetryAttempt(req.Context()) > 0 {
+			m.retries.WithLabelValues(entity, operation).Inc()
+		}
+
+		m.inFlight.Inc()
+		defer m.inFlight.Dec()
+
+		start := time.Now()
+		res, err := next(req, value)
+
+		m.latency.WithLabelValues(entity, operation).Observe(time.Since(start).Seconds())
+
+		code := "error"
+
+		if res != nil {
+			code = strconv.Itoa(res.StatusCode)
+		}
+
+		m.requests.WithLabelValues(entity, operation, code).Inc()
+
+		return res, err
+	}
+}
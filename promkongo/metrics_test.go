@@ -0,0 +1,123 @@
+package promkongo
+
+import (
+	"fmt"
+	"github.com/fabiorphp/kongo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type MetricsTestSuite struct {
+	suite.Suite
+
+	assert *assert.Assertions
+	client *kongo.Kongo
+
+	mux    *http.ServeMux
+	server *httptest.Server
+
+	registry *prometheus.Registry
+	metrics  *Metrics
+}
+
+func (s *MetricsTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.server = httptest.NewServer(s.mux)
+
+	s.client, _ = kongo.New(nil, s.server.URL)
+
+	s.registry = prometheus.NewRegistry()
+	s.metrics, _ = NewMetrics(s.registry)
+
+	s.client.Use(s.metrics.Interceptor())
+
+	s.assert = assert.New(s.T())
+}
+
+func (s *MetricsTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *MetricsTestSuite) TestRegisterTwice() {
+	_, err := NewMetrics(s.registry)
+
+	s.assert.Error(err)
+}
+
+func (s *MetricsTestSuite) TestWithoutRegisterer() {
+	metrics, err := NewMetrics(nil)
+
+	s.assert.Nil(err)
+	s.assert.NotNil(metrics)
+}
+
+func (s *MetricsTestSuite) TestRequestsByStatusCode() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	s.mux.HandleFunc("/services/bar", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	s.client.Services.Get("foo")
+	s.client.Services.Get("foo")
+	s.client.Services.Get("bar")
+
+	expected := `
+# HELP kongo_requests_total Number of Admin API requests by entity, operation and status code.
+# TYPE kongo_requests_total counter
+kongo_requests_total{code="200",entity="service",operation="Get"} 2
+kongo_requests_total{code="404",entity="service",operation="Get"} 1
+`
+
+	s.assert.Nil(testutil.GatherAndCompare(s.registry, strings.NewReader(expected), "kongo_requests_total"))
+	s.assert.Equal(1, testutil.CollectAndCount(s.metrics.latency))
+	s.assert.Equal(float64(0), testutil.ToFloat64(s.metrics.inFlight))
+}
+
+func (s *MetricsTestSuite) TestRetries() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	pool, _ := kongo.NewEndpointPool(down.URL, s.server.URL)
+
+	client, _ := kongo.New(nil, s.server.URL)
+	client.Use(pool.Interceptor(), s.metrics.Interceptor())
+
+	client.Node.Status()
+
+	s.assert.Equal(float64(1), testutil.ToFloat64(s.metrics.retries.WithLabelValues("node", "Status")))
+}
+
+func (s *MetricsTestSuite) TestInFlight() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(float64(1), testutil.ToFloat64(s.metrics.inFlight))
+
+		fmt.Fprint(w, `{}`)
+	})
+
+	s.client.Node.Status()
+}
+
+func (s *MetricsTestSuite) TestTransportError() {
+	s.server.Close()
+
+	s.client.Node.Status()
+
+	s.assert.Equal(float64(1), testutil.ToFloat64(s.metrics.requests.WithLabelValues("node", "Status", "error")))
+}
+
+func TestMetricsTestSuite(t *testing.T) {
+	suite.Run(t, new(MetricsTestSuite))
+}
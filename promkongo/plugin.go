@@ -0,0 +1,274 @@
+package promkongo
+
+import (
+	"context"
+	"github.com/fabiorphp/kongo"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const (
+	pluginMetricsPath = "/metrics"
+)
+
+type (
+	// KongMetrics it's a structure of the prometheus plugin metrics.
+	KongMetrics struct {
+		// Whether the node reaches the datastore.
+		DatastoreReachable bool
+
+		// Traffic metrics by service name.
+		Services map[string]*ServiceMetrics
+
+		// Traffic metrics by route ID or name.
+		Routes map[string]*RouteMetrics
+	}
+
+	// ServiceMetrics it's the traffic of a service, summed across its routes.
+	ServiceMetrics struct {
+		TrafficMetrics
+
+		// The service name.
+		Service string
+
+		// Traffic metrics of the service routes by route ID or name.
+		Routes map[string]*RouteMetrics
+	}
+
+	// RouteMetrics it's the traffic of a route.
+	RouteMetrics struct {
+		TrafficMetrics
+
+		// The name of the service the route is associated to.
+		Service string
+
+		// The route ID or name.
+		Route string
+	}
+
+	// TrafficMetrics it's the bandwidth, status and latency metrics of a service or route.
+	TrafficMetrics struct {
+		// Bytes received from the clients.
+		IngressBytes float64
+
+		// Bytes sent to the clients.
+		EgressBytes float64
+
+		// Number of requests by HTTP status code.
+		Requests map[int]float64
+
+		// Latency histograms by type: kong, request or upstream.
+		Latencies map[string]*LatencyHistogram
+	}
+
+	// LatencyHistogram it's a latency histogram in milliseconds.
+	LatencyHistogram struct {
+		// Number of observations.
+		Count uint64
+
+		// Sum of the observations.
+		Sum float64
+
+		// Cumulative number of observations by upper bound.
+		Buckets map[float64]uint64
+	}
+)
+
+// latencyMetrics maps the latency histograms of Kong 3.x to their types.
+var latencyMetrics = map[string]string{
+	"kong_kong_latency_ms":     "kong",
+	"kong_request_latency_ms":  "request",
+	"kong_upstream_latency_ms": "upstream",
+}
+
+// PluginMetrics retrieves the metrics exposed by the Kong prometheus plugin on the Admin API.
+func PluginMetrics(ctx context.Context, k *kongo.Kongo) (*KongMetrics, *kongo.Response, error) {
+	res, err := kongo.CallRaw(ctx, k, http.MethodGet, pluginMetricsPath, nil)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	defer res.Body.Close()
+
+	metrics, err := ParseKongMetrics(res.Body)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	return metrics, res, nil
+}
+
+// ParseKongMetrics parses the prometheus plugin metrics in text format, from Kong 1.x up to 3.x.
+func ParseKongMetrics(r io.Reader) (*KongMetrics, error) {
+	var parser expfmt.TextParser
+
+	families, err := parser.TextToMetricFamilies(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := &KongMetrics{
+		Services: map[string]*ServiceMetrics{},
+		Routes:   map[string]*RouteMetrics{},
+	}
+
+	for name, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := metricLabels(metric)
+
+			switch name {
+			case "kong_datastore_reachable":
+				m.DatastoreReachable = metricValue(metric) == 1
+			case "kong_bandwidth", "kong_bandwidth_bytes":
+				direction := labels["type"]
+
+				if direction == "" {
+					direction = labels["direction"]
+				}
+
+				value := metricValue(metric)
+
+				m.traffic(labels, func(t *TrafficMetrics) {
+					switch direction {
+					case "ingress":
+						t.IngressBytes += value
+					case "egress":
+						t.EgressBytes += value
+					}
+				})
+			case "kong_http_status", "kong_http_requests_total":
+				code, err := strconv.Atoi(labels["code"])
+
+				if err != nil {
+					continue
+				}
+
+				value := metricValue(metric)
+
+				m.traffic(labels, func(t *TrafficMetrics) {
+					t.Requests[code] += value
+				})
+			case "kong_latency", "kong_kong_latency_ms", "kong_request_latency_ms", "kong_upstream_latency_ms":
+				latencyType := labels["type"]
+
+				if t, ok := latencyMetrics[name]; ok {
+					latencyType = t
+				}
+
+				histogram := metric.GetHistogram()
+
+				m.traffic(labels, func(t *TrafficMetrics) {
+					t.latency(latencyType).add(histogram)
+				})
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Service retrieves the traffic metrics of the service, labelled by its name or, when unnamed, by its ID.
+func (m *KongMetrics) Service(svc *kongo.Service) *ServiceMetrics {
+	if metrics, ok := m.Services[svc.Name]; ok && svc.Name != "" {
+		return metrics
+	}
+
+	return m.Services[svc.Id]
+}
+
+// Route retrieves the traffic metrics of the route, labelled by its name or, when unnamed, by its ID.
+func (m *KongMetrics) Route(route *kongo.Route) *RouteMetrics {
+	if metrics, ok := m.Routes[route.Name]; ok && route.Name != "" {
+		return metrics
+	}
+
+	return m.Routes[route.Id]
+}
+
+// traffic applies the metric to the service and to the route labelled.
+func (m *KongMetrics) traffic(labels map[string]string, apply func(t *TrafficMetrics)) {
+	service, route := labels["service"], labels["route"]
+
+	if service == "" {
+		return
+	}
+
+	svc, ok := m.Services[service]
+
+	if !ok {
+		svc = &ServiceMetrics{TrafficMetrics: newTrafficMetrics(), Service: service, Routes: map[string]*RouteMetrics{}}
+		m.Services[service] = svc
+	}
+
+	apply(&svc.TrafficMetrics)
+
+	if route == "" {
+		return
+	}
+
+	r, ok := m.Routes[route]
+
+	if !ok {
+		r = &RouteMetrics{TrafficMetrics: newTrafficMetrics(), Service: service, Route: route}
+		m.Routes[route] = r
+		svc.Routes[route] = r
+	}
+
+	apply(&r.TrafficMetrics)
+}
+
+// newTrafficMetrics returns empty traffic metrics.
+func newTrafficMetrics() TrafficMetrics {
+	return TrafficMetrics{Requests: map[int]float64{}, Latencies: map[string]*LatencyHistogram{}}
+}
+
+// latency retrieves the latency histogram of the type, creating it when missing.
+func (t *TrafficMetrics) latency(latencyType string) *LatencyHistogram {
+	h, ok := t.Latencies[latencyType]
+
+	if !ok {
+		h = &LatencyHistogram{Buckets: map[float64]uint64{}}
+		t.Latencies[latencyType] = h
+	}
+
+	return h
+}
+
+// add sums the histogram observations.
+func (h *LatencyHistogram) add(histogram *dto.Histogram) {
+	h.Count += histogram.GetSampleCount()
+	h.Sum += histogram.GetSampleSum()
+
+	for _, bucket := range histogram.GetBucket() {
+		h.Buckets[bucket.GetUpperBound()] += bucket.GetCumulativeCount()
+	}
+}
+
+// metricLabels returns the metric labels by name.
+func metricLabels(metric *dto.Metric) map[string]string {
+	labels := map[string]string{}
+
+	for _, label := range metric.GetLabel() {
+		labels[label.GetName()] = label.GetValue()
+	}
+
+	return labels
+}
+
+// metricValue returns the value of a counter, gauge or untyped metric.
+func metricValue(metric *dto.Metric) float64 {
+	switch {
+	case metric.Counter != nil:
+		return metric.GetCounter().GetValue()
+	case metric.Gauge != nil:
+		return metric.GetGauge().GetValue()
+	}
+
+	return metric.GetUntyped().GetValue()
+}
@@ -0,0 +1,144 @@
+package promkongo
+
+import (
+	"context"
+	"fmt"
+	"github.com/fabiorphp/kongo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type PluginTestSuite struct {
+	suite.Suite
+
+	assert *assert.Assertions
+	client *kongo.Kongo
+
+	mux    *http.ServeMux
+	server *httptest.Server
+}
+
+func (s *PluginTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.server = httptest.NewServer(s.mux)
+
+	s.client, _ = kongo.New(nil, s.server.URL)
+
+	s.assert = assert.New(s.T())
+}
+
+func (s *PluginTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *PluginTestSuite) TestPluginMetricsReturnsHttpError() {
+	s.mux.HandleFunc(pluginMetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, res, err := PluginMetrics(context.Background(), s.client)
+
+	s.assert.IsType(&kongo.Response{}, res)
+	s.assert.EqualError(err, "404 Request error")
+}
+
+func (s *PluginTestSuite) TestPluginMetricsWithInvalidFormat() {
+	s.mux.HandleFunc(pluginMetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "kong_bandwidth{service=} 1\n")
+	})
+
+	_, res, err := PluginMetrics(context.Background(), s.client)
+
+	s.assert.IsType(&kongo.Response{}, res)
+	s.assert.Error(err)
+}
+
+func (s *PluginTestSuite) TestPluginMetrics() {
+	s.mux.HandleFunc(pluginMetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		file, _ := os.Open("fixtures/prometheus_metrics.txt")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	metrics, res, err := PluginMetrics(context.Background(), s.client)
+
+	s.assert.IsType(&kongo.Response{}, res)
+	s.assert.Nil(err)
+	s.assert.True(metrics.DatastoreReachable)
+	s.assert.Len(metrics.Services, 2)
+	s.assert.Len(metrics.Routes, 3)
+
+	foo := metrics.Service(&kongo.Service{Name: "foo"})
+
+	s.assert.Equal(float64(1377), foo.EgressBytes)
+	s.assert.Equal(float64(254), foo.IngressBytes)
+	s.assert.Equal(map[int]float64{200: 7, 502: 1}, foo.Requests)
+	s.assert.Len(foo.Routes, 2)
+
+	route := metrics.Route(&kongo.Route{Id: "22108377-8f26-4c0e-bd9e-2962c1d6b0e6"})
+
+	s.assert.Equal("foo", route.Service)
+	s.assert.Equal(float64(1277), route.EgressBytes)
+	s.assert.Equal(map[int]float64{200: 5, 502: 1}, route.Requests)
+	s.assert.Equal(uint64(6), route.Latencies["request"].Count)
+	s.assert.Equal(float64(180), route.Latencies["request"].Sum)
+	s.assert.Equal(uint64(5), route.Latencies["request"].Buckets[50])
+	s.assert.Equal(uint64(6), route.Latencies["upstream"].Buckets[math.Inf(1)])
+
+	s.assert.Nil(metrics.Route(&kongo.Route{Id: "unknown"}))
+}
+
+func (s *PluginTestSuite) TestLookupByNameThenId() {
+	metrics, err := ParseKongMetrics(strings.NewReader(`# TYPE kong_http_status counter
+kong_http_status{service="foo",route="bar",code="200"} 1
+kong_http_status{service="4e13f54a-bbf1-47a8-8777-255fed7116f2",route="22108377-8f26-4c0e-bd9e-2962c1d6b0e6",code="200"} 2
+`))
+
+	s.assert.Nil(err)
+
+	s.assert.Equal(float64(1), metrics.Service(&kongo.Service{Id: "1", Name: "foo"}).Requests[200])
+	s.assert.Equal(float64(2), metrics.Service(&kongo.Service{Id: "4e13f54a-bbf1-47a8-8777-255fed7116f2"}).Requests[200])
+	s.assert.Equal(float64(2), metrics.Service(&kongo.Service{Id: "4e13f54a-bbf1-47a8-8777-255fed7116f2", Name: "baz"}).Requests[200])
+
+	s.assert.Equal(float64(1), metrics.Route(&kongo.Route{Id: "1", Name: "bar"}).Requests[200])
+	s.assert.Equal(float64(2), metrics.Route(&kongo.Route{Id: "22108377-8f26-4c0e-bd9e-2962c1d6b0e6"}).Requests[200])
+	s.assert.Nil(metrics.Route(&kongo.Route{Name: "unknown"}))
+}
+
+func (s *PluginTestSuite) TestParseKong3Metrics() {
+	metrics, err := ParseKongMetrics(strings.NewReader(`# TYPE kong_bandwidth_bytes counter
+kong_bandwidth_bytes{direction="ingress",service="foo",route="r1",consumer=""} 10
+# TYPE kong_http_requests_total counter
+kong_http_requests_total{service="foo",route="r1",code="201",source="service",consumer=""} 4
+kong_http_requests_total{service="",route="",code="404",source="kong",consumer=""} 9
+# TYPE kong_kong_latency_ms histogram
+kong_kong_latency_ms_bucket{service="foo",route="r1",le="1"} 4
+kong_kong_latency_ms_bucket{service="foo",route="r1",le="+Inf"} 4
+kong_kong_latency_ms_sum{service="foo",route="r1"} 2
+kong_kong_latency_ms_count{service="foo",route="r1"} 4
+# TYPE kong_datastore_reachable gauge
+kong_datastore_reachable 0
+`))
+
+	s.assert.Nil(err)
+	s.assert.False(metrics.DatastoreReachable)
+	s.assert.Len(metrics.Services, 1)
+	s.assert.Equal(float64(10), metrics.Routes["r1"].IngressBytes)
+	s.assert.Equal(map[int]float64{201: 4}, metrics.Routes["r1"].Requests)
+	s.assert.Equal(uint64(4), metrics.Routes["r1"].Latencies["kong"].Count)
+}
+
+func TestPluginTestSuite(t *testing.T) {
+	suite.Run(t, new(PluginTestSuite))
+}
@@ -0,0 +1,59 @@
+package kongo
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type (
+	// Response it's an API response. The body is already consumed, the request that produced it is available
+	// through the embedded http.Response.
+	Response struct {
+		*http.Response
+
+		// Time spent by Kong handling the request, from the X-Kong-Admin-Latency header.
+		KongLatency time.Duration
+
+		// Identification of the request, from the X-Kong-Admin-Request-ID header, useful to match Kong logs.
+		RequestID string
+
+		// Path of the next page, empty on the last page of a list.
+		Next string
+
+		// Cursor of the next page, to be set as the Offset of the list options.
+		Offset string
+	}
+
+	// paginated it's a list result carrying the next page cursor.
+	paginated interface {
+		page() (next string, offset string)
+	}
+)
+
+// send sends an API request through Do and wraps the response.
+func (k *Kongo) send(req *http.Request, value interface{}) (*Response, error) {
+	res, err := k.Do(req, value)
+
+	return newResponse(res, value), err
+}
+
+// newResponse wraps the HTTP response parsing the Kong headers and the page cursor of the value, nil when no
+// response was received.
+func newResponse(res *http.Response, value interface{}) *Response {
+	if res == nil {
+		return nil
+	}
+
+	response := &Response{Response: res, RequestID: res.Header.Get("X-Kong-Admin-Request-ID")}
+
+	if latency, err := strconv.Atoi(res.Header.Get("X-Kong-Admin-Latency")); err == nil {
+		response.KongLatency = time.Duration(latency) * time.Millisecond
+	}
+
+	if p, ok := value.(paginated); ok {
+		response.Next, response.Offset = p.page()
+	}
+
+	return response
+}
@@ -0,0 +1,72 @@
+package kongo
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type ResponseTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *ResponseTestSuite) TestKongHeaders() {
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Kong-Admin-Latency", "12")
+		w.Header().Set("X-Kong-Admin-Request-ID", "yyB4Lb4d4ZVCkqqGdfOJzxWfPDDHnvml")
+
+		file, _ := s.LoadFixture("fixtures/node_status_payload.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	_, res, err := s.client.Node.Status()
+
+	s.assert.Nil(err)
+	s.assert.Equal(12*time.Millisecond, res.KongLatency)
+	s.assert.Equal("yyB4Lb4d4ZVCkqqGdfOJzxWfPDDHnvml", res.RequestID)
+	s.assert.Equal(nodeStatusResourcePath, res.Request.URL.Path)
+	s.assert.Empty(res.Next)
+}
+
+func (s *ResponseTestSuite) TestPageCursor() {
+	s.mux.HandleFunc(servicesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		file, _ := s.LoadFixture("fixtures/services_list.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	_, res, err := s.client.Services.List(nil)
+
+	s.assert.Nil(err)
+	s.assert.Equal("/services?offset=WyIwZGFhZDUzNy02Njk5LTQ3NjUtYmFhMS1kYmU3NGE5NWQ1NDEiXQ", res.Next)
+	s.assert.Equal("WyIwZGFhZDUzNy02Njk5LTQ3NjUtYmFhMS1kYmU3NGE5NWQ1NDEiXQ", res.Offset)
+	s.assert.Zero(res.KongLatency)
+}
+
+func (s *ResponseTestSuite) TestErrorResponse() {
+	s.mux.HandleFunc(routesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Kong-Admin-Request-ID", "abc")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, res, err := s.client.Routes.Get("foo")
+
+	s.assert.Error(err)
+	s.assert.Equal(http.StatusNotFound, res.StatusCode)
+	s.assert.Equal("abc", res.RequestID)
+}
+
+func (s *ResponseTestSuite) TestNoResponse() {
+	s.assert.Nil(newResponse(nil, nil))
+}
+
+func TestResponseTestSuite(t *testing.T) {
+	suite.Run(t, new(ResponseTestSuite))
+}
@@ -0,0 +1,118 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type (
+	// RetryOptions stores the options you can set for resending the failed requests.
+	RetryOptions struct {
+		// Number of times a failed request is resent. Defaults to 2.
+		Retries int
+
+		// Wait before the retry attempt, starting at 1. Defaults to an exponential backoff from 100ms up to 2s.
+		Backoff func(attempt int) time.Duration
+	}
+)
+
+// RetryInterceptor returns an interceptor that resends the requests failed on the network or with a 5xx
+// response, flagging every retry with its attempt so the interceptors that run after it, e.g. metrics, can tell
+// retries apart. Requests are not idempotent on every endpoint, e.g. creates, so use it carefully on writes.
+func RetryInterceptor(options *RetryOptions) Interceptor {
+	opts := RetryOptions{}
+
+	if options != nil {
+		opts = *options
+	}
+
+	if opts.Retries <= 0 {
+		opts.Retries = 2
+	}
+
+	if opts.Backoff == nil {
+		opts.Backoff = exponentialBackoff
+	}
+
+	return func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		ctx := req.Context()
+
+		for attempt := 1; ; attempt++ {
+			res, err := next(req, value)
+
+			if attempt > opts.Retries || !retryable(res, err) || ctx.Err() != nil {
+				return res, err
+			}
+
+			if err := wait(ctx, opts.Backoff(attempt)); err != nil {
+				return res, err
+			}
+
+			if req, err = retryRequest(req, attempt); err != nil {
+				return res, err
+			}
+		}
+	}
+}
+
+// retryable reports whether the request failed on the network or with a 5xx response. Other errors, e.g. a
+// response body that can't be decoded, don't mean the request failed on the server.
+func retryable(res *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var urlError *url.Error
+
+	if errors.As(err, &urlError) {
+		return true
+	}
+
+	var errorResponse *ErrorResponse
+
+	return errors.As(err, &errorResponse) && res != nil && res.StatusCode >= http.StatusInternalServerError
+}
+
+// retryRequest returns a copy of the request flagged with the retry attempt, with a fresh body.
+func retryRequest(req *http.Request, attempt int) (*http.Request, error) {
+	retry := req.Clone(withRetryAttempt(req.Context(), attempt))
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+
+		if err != nil {
+			return nil, err
+		}
+
+		retry.Body = body
+	}
+
+	return retry, nil
+}
+
+// wait sleeps for the duration or until the context is done.
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// exponentialBackoff doubles the wait on every attempt, from 100ms up to 2s.
+func exponentialBackoff(attempt int) time.Duration {
+	backoff := 100 * time.Millisecond << uint(attempt-1)
+
+	if backoff <= 0 || backoff > 2*time.Second {
+		return 2 * time.Second
+	}
+
+	return backoff
+}
@@ -0,0 +1,96 @@
+package kongo
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type RetryTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *RetryTestSuite) TestRetryInterceptor() {
+	attempts := []int{}
+	bodies := []string{}
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "1", "host": "foo.org"}`)
+	})
+
+	ctx := ContextWithInterceptors(context.Background(), RetryInterceptor(&RetryOptions{
+		Backoff: func(attempt int) time.Duration { return 0 },
+	}))
+
+	s.client.Use(func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		attempts = append(attempts, RetryAttempt(req.Context()))
+
+		return next(req, value)
+	})
+
+	svc, _, err := s.client.Services.UpdateWithContext(ctx, "foo", &Service{Host: "foo.org"})
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+	s.assert.Equal([]int{0, 1, 2}, attempts)
+	s.assert.Equal(bodies[0], bodies[2])
+}
+
+func (s *RetryTestSuite) TestOnlyNetworkAndServerErrorsAreRetried() {
+	attempts := 0
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		fmt.Fprint(w, `{"id": `)
+	})
+
+	s.mux.HandleFunc("/routes/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	ctx := ContextWithInterceptors(context.Background(), RetryInterceptor(&RetryOptions{
+		Backoff: func(attempt int) time.Duration { return 0 },
+	}))
+
+	_, _, err := s.client.Services.GetWithContext(ctx, "foo")
+
+	s.assert.Error(err)
+
+	_, _, err = s.client.Routes.GetWithContext(ctx, "1")
+
+	s.assert.Error(err)
+	s.assert.Equal(2, attempts)
+
+	s.server.Close()
+
+	_, _, err = s.client.Services.GetWithContext(ctx, "foo")
+
+	s.assert.True(retryable(nil, err))
+}
+
+func (s *RetryTestSuite) TestExponentialBackoff() {
+	s.assert.Equal(100*time.Millisecond, exponentialBackoff(1))
+	s.assert.Equal(200*time.Millisecond, exponentialBackoff(2))
+	s.assert.Equal(2*time.Second, exponentialBackoff(6))
+	s.assert.Equal(2*time.Second, exponentialBackoff(100))
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
@@ -2,6 +2,7 @@ package kongo
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/google/go-querystring/query"
 	"net/http"
 	"net/url"
@@ -16,34 +17,40 @@ type (
 	// Routes manages the Kong route rules.
 	Routes interface {
 		// Create creates a new route.
-		Create(route *Route) (*Route, *http.Response, error)
+		Create(route *Route) (*Route, *Response, error)
 
 		// CreateWithContext creates a new route.
-		CreateWithContext(ctx context.Context, route *Route) (*Route, *http.Response, error)
+		CreateWithContext(ctx context.Context, route *Route) (*Route, *Response, error)
 
 		// Delete deletes registered route by ID or Name.
-		Delete(id string) (*http.Response, error)
+		Delete(id string) (*Response, error)
 
 		// DeleteWithContext deletes registered route by ID or Name.
-		DeleteWithContext(ctx context.Context, id string) (*http.Response, error)
+		DeleteWithContext(ctx context.Context, id string) (*Response, error)
 
 		// Get retrieves registered route by ID.
-		Get(id string) (*Route, *http.Response, error)
+		Get(id string) (*Route, *Response, error)
 
 		// GetWithContext retrieves registered route by ID.
-		GetWithContext(ctx context.Context, id string) (*Route, *http.Response, error)
+		GetWithContext(ctx context.Context, id string) (*Route, *Response, error)
 
 		// List retrieves a list of registered routes.
-		List(options *ListRoutesOptions) ([]*Route, *http.Response, error)
+		List(options *ListRoutesOptions) ([]*Route, *Response, error)
 
 		// ListWithContext retrieves a list of registered routes.
-		ListWithContext(ctx context.Context, options *ListRoutesOptions) ([]*Route, *http.Response, error)
+		ListWithContext(ctx context.Context, options *ListRoutesOptions) ([]*Route, *Response, error)
 
 		// Update updates a route registered by ID.
-		Update(id string, route *Route) (*Route, *http.Response, error)
+		Update(id string, route *Route) (*Route, *Response, error)
 
 		// UpdateWithContext updates a route registered by ID.
-		UpdateWithContext(ctx context.Context, id string, route *Route) (*Route, *http.Response, error)
+		UpdateWithContext(ctx context.Context, id string, route *Route) (*Route, *Response, error)
+
+		// UpdateIfUnchanged updates a route registered by ID when it was not changed since the seen version.
+		UpdateIfUnchanged(id string, seen *Route, route *Route) (*Route, *Response, error)
+
+		// UpdateIfUnchangedWithContext updates a route registered by ID when it was not changed since the seen version.
+		UpdateIfUnchangedWithContext(ctx context.Context, id string, seen *Route, route *Route) (*Route, *Response, error)
 	}
 
 	// RoutesService it's a concrete instance of route.
@@ -66,9 +73,15 @@ type (
 		// A list of HTTP methods that match this Route. At least one of hosts, paths, or methods must be set.
 		Methods []string `json:"methods,omitempty"`
 
+		// The name of the route. Requires Kong 0.14 or later.
+		Name string `json:"name,omitempty"`
+
 		// A list of paths that match this Route. At least one of hosts, paths, or methods must be set.
 		Paths []string `json:"paths,omitempty"`
 
+		// Controls how the Service path, Route path and requested path are combined when sending a request to the upstream. Requires Kong 2.0 or later.
+		PathHandling string `json:"path_handling,omitempty"`
+
 		// When matching a Route via one of the hosts domain names, use the request Host header in the upstream request headers.
 		PreserveHost bool `json:"preserve_host,omitempty"`
 
@@ -81,8 +94,20 @@ type (
 		// When matching a Route via one of the paths, strip the matching prefix from the upstream request URL.
 		StripPath bool `json:"strip_path,omitempty"`
 
+		// An optional set of strings associated with the Route, for grouping and filtering. Requires Kong 1.1 or later.
+		Tags []string `json:"tags,omitempty"`
+
 		// The date when the route was updated.
 		UpdatedAt Time `json:"updated_at"`
+
+		// Fields returned by Kong that are not mapped yet, re-emitted when the route is marshalled.
+		Extras map[string]json.RawMessage `json:"-"`
+
+		// Field names, e.g. StripPath, sent on create and update even when they hold the zero value.
+		ForceSendFields []string `json:"-"`
+
+		// Field names, e.g. Hosts, sent as null on create and update, clearing their values.
+		NullFields []string `json:"-"`
 	}
 
 	// RouteService it's a structure of API result.
@@ -95,6 +120,12 @@ type (
 	RoutesRoot struct {
 		// List of routes.
 		Routes []*Route `json:"data"`
+
+		// Path of the next page, empty on the last page.
+		Next string `json:"next,omitempty"`
+
+		// Cursor of the next page.
+		Offset string `json:"offset,omitempty"`
 	}
 
 	// ListRoutesOptions stores the options you can set for requesting the route list.
@@ -108,10 +139,26 @@ type (
 )
 
 // CreateWithContext creates a new route.
-func (r *RoutesService) CreateWithContext(ctx context.Context, route *Route) (*Route, *http.Response, error) {
+func (r *RoutesService) CreateWithContext(ctx context.Context, route *Route) (*Route, *Response, error) {
+	ctx = withOperation(ctx, "Routes", "Create", "route", "")
+
 	resource, _ := url.Parse(routesResourcePath)
 
-	req, err := r.client.NewRequest(ctx, http.MethodPost, resource, route)
+	if err := r.client.require(ctx, route.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.client.validate(route, false); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := routeBody(route)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPost, resource, body)
 
 	if err != nil {
 		return nil, nil, err
@@ -119,7 +166,7 @@ func (r *RoutesService) CreateWithContext(ctx context.Context, route *Route) (*R
 
 	root := new(Route)
 
-	res, err := r.client.Do(req, root)
+	res, err := r.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -129,12 +176,14 @@ func (r *RoutesService) CreateWithContext(ctx context.Context, route *Route) (*R
 }
 
 // Create creates a new route.
-func (r *RoutesService) Create(route *Route) (*Route, *http.Response, error) {
+func (r *RoutesService) Create(route *Route) (*Route, *Response, error) {
 	return r.CreateWithContext(context.TODO(), route)
 }
 
 // DeleteWithContext retrieves registered route by ID.
-func (r *RoutesService) DeleteWithContext(ctx context.Context, id string) (*http.Response, error) {
+func (r *RoutesService) DeleteWithContext(ctx context.Context, id string) (*Response, error) {
+	ctx = withOperation(ctx, "Routes", "Delete", "route", id)
+
 	resource, _ := url.Parse(routesResourcePath)
 	resource.Path = path.Join(resource.Path, id)
 
@@ -144,16 +193,18 @@ func (r *RoutesService) DeleteWithContext(ctx context.Context, id string) (*http
 		return nil, err
 	}
 
-	return r.client.Do(req, nil)
+	return r.client.send(req, nil)
 }
 
 // Delete retrieves registered route by ID or Name.
-func (r *RoutesService) Delete(id string) (*http.Response, error) {
+func (r *RoutesService) Delete(id string) (*Response, error) {
 	return r.DeleteWithContext(context.TODO(), id)
 }
 
 // GetWithContext retrieves registered route by ID.
-func (r *RoutesService) GetWithContext(ctx context.Context, id string) (*Route, *http.Response, error) {
+func (r *RoutesService) GetWithContext(ctx context.Context, id string) (*Route, *Response, error) {
+	ctx = withOperation(ctx, "Routes", "Get", "route", id)
+
 	resource, _ := url.Parse(routesResourcePath)
 	resource.Path = path.Join(resource.Path, id)
 
@@ -165,7 +216,7 @@ func (r *RoutesService) GetWithContext(ctx context.Context, id string) (*Route,
 
 	route := new(Route)
 
-	res, err := r.client.Do(req, route)
+	res, err := r.client.send(req, route)
 
 	if err != nil {
 		return nil, res, err
@@ -175,12 +226,14 @@ func (r *RoutesService) GetWithContext(ctx context.Context, id string) (*Route,
 }
 
 // Get retrieves registered route by ID.
-func (r *RoutesService) Get(id string) (*Route, *http.Response, error) {
+func (r *RoutesService) Get(id string) (*Route, *Response, error) {
 	return r.GetWithContext(context.TODO(), id)
 }
 
 // ListWithContext retrieves a list of registered routes.
-func (r *RoutesService) ListWithContext(ctx context.Context, options *ListRoutesOptions) ([]*Route, *http.Response, error) {
+func (r *RoutesService) ListWithContext(ctx context.Context, options *ListRoutesOptions) ([]*Route, *Response, error) {
+	ctx = withOperation(ctx, "Routes", "List", "route", "")
+
 	opts, _ := query.Values(options)
 	resource, _ := url.Parse(routesResourcePath)
 	resource.RawQuery = opts.Encode()
@@ -193,7 +246,7 @@ func (r *RoutesService) ListWithContext(ctx context.Context, options *ListRoutes
 
 	root := new(RoutesRoot)
 
-	res, err := r.client.Do(req, root)
+	res, err := r.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -203,16 +256,32 @@ func (r *RoutesService) ListWithContext(ctx context.Context, options *ListRoutes
 }
 
 // List retrieves a list of registered routes.
-func (r *RoutesService) List(options *ListRoutesOptions) ([]*Route, *http.Response, error) {
+func (r *RoutesService) List(options *ListRoutesOptions) ([]*Route, *Response, error) {
 	return r.ListWithContext(context.TODO(), options)
 }
 
 // UpdateWithContext updates a route.
-func (r *RoutesService) UpdateWithContext(ctx context.Context, id string, route *Route) (*Route, *http.Response, error) {
+func (r *RoutesService) UpdateWithContext(ctx context.Context, id string, route *Route) (*Route, *Response, error) {
+	ctx = withOperation(ctx, "Routes", "Update", "route", id)
+
 	resource, _ := url.Parse(routesResourcePath)
 	resource.Path = path.Join(resource.Path, id)
 
-	req, err := r.client.NewRequest(ctx, http.MethodPatch, resource, route)
+	if err := r.client.require(ctx, route.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := r.client.validate(route, true); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := routeBody(route)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := r.client.NewRequest(ctx, http.MethodPatch, resource, body)
 
 	if err != nil {
 		return nil, nil, err
@@ -220,7 +289,7 @@ func (r *RoutesService) UpdateWithContext(ctx context.Context, id string, route
 
 	root := new(Route)
 
-	res, err := r.client.Do(req, root)
+	res, err := r.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -230,6 +299,167 @@ func (r *RoutesService) UpdateWithContext(ctx context.Context, id string, route
 }
 
 // Update updates a route.
-func (r *RoutesService) Update(id string, route *Route) (*Route, *http.Response, error) {
+func (r *RoutesService) Update(id string, route *Route) (*Route, *Response, error) {
 	return r.UpdateWithContext(context.TODO(), id, route)
 }
+
+// UpdateIfUnchangedWithContext re-reads the route and updates it only when it matches the seen version,
+// returning ErrConflict otherwise. Kong has no conditional requests, so a write between the read and the
+// update is still possible, the check only narrows that window.
+func (r *RoutesService) UpdateIfUnchangedWithContext(ctx context.Context, id string, seen *Route, route *Route) (*Route, *Response, error) {
+	current, res, err := r.GetWithContext(ctx, id)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	ok, err := unchanged(seen.UpdatedAt, current.UpdatedAt, seen, current)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	if !ok {
+		return nil, res, ErrConflict
+	}
+
+	return r.UpdateWithContext(ctx, id, route)
+}
+
+// UpdateIfUnchanged updates a route registered by ID when it was not changed since the seen version.
+func (r *RoutesService) UpdateIfUnchanged(id string, seen *Route, route *Route) (*Route, *Response, error) {
+	return r.UpdateIfUnchangedWithContext(context.TODO(), id, seen, route)
+}
+
+// ModifyRoute reads the route, applies the mutation on a copy and updates it when unchanged, starting over
+// on conflicts up to the given attempts.
+func ModifyRoute(ctx context.Context, routes Routes, id string, attempts int, mutate func(route *Route) error) (*Route, *Response, error) {
+	var (
+		res *Response
+		err error
+	)
+
+	for i := 0; i < attempts; i++ {
+		var seen *Route
+
+		seen, res, err = routes.GetWithContext(ctx, id)
+
+		if err != nil {
+			return nil, res, err
+		}
+
+		route := new(Route)
+
+		if err = deepCopy(seen, route); err != nil {
+			return nil, res, err
+		}
+
+		if err = mutate(route); err != nil {
+			return nil, res, err
+		}
+
+		route, res, err = routes.UpdateIfUnchangedWithContext(ctx, id, seen, route)
+
+		if err != ErrConflict {
+			return route, res, err
+		}
+	}
+
+	return nil, res, ErrConflict
+}
+
+// capabilities returns the Kong capabilities needed by the route attributes.
+func (r *Route) capabilities() []Capability {
+	capabilities := []Capability{}
+
+	if r == nil {
+		return capabilities
+	}
+
+	if len(r.Tags) > 0 {
+		capabilities = append(capabilities, CapabilityTags)
+	}
+
+	if r.PathHandling != "" {
+		capabilities = append(capabilities, CapabilityPathHandling)
+	}
+
+	return capabilities
+}
+
+// routeBody returns the route attributes sent on create and update. The empty id and service and the null
+// attributes, e.g. the unset dates, are left out unless listed in the field masks.
+func routeBody(route *Route) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(route)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, err
+	}
+
+	masked, err := fieldMaskValues(route, route.ForceSendFields, route.NullFields, "")
+
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range body {
+		if _, ok := masked[key]; ok {
+			continue
+		}
+
+		if string(value) == "null" || (key == "id" && route.Id == "") || (key == "service" && route.Service.Id == "") {
+			delete(body, key)
+		}
+	}
+
+	return body, nil
+}
+
+// UnmarshalJSON unmarshals the route keeping the fields that are not mapped.
+func (r *Route) UnmarshalJSON(data []byte) error {
+	type route Route
+
+	if err := json.Unmarshal(data, (*route)(r)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, r)
+
+	if err != nil {
+		return err
+	}
+
+	r.Extras = extras
+
+	return nil
+}
+
+// MarshalJSON marshals the route including the fields that are not mapped.
+func (r Route) MarshalJSON() ([]byte, error) {
+	type route Route
+
+	data, err := json.Marshal(route(r))
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = mergeExtras(data, r.Extras)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return applyFieldMasks(data, r, r.ForceSendFields, r.NullFields)
+}
+
+// page returns the next page cursor.
+func (r *RoutesRoot) page() (string, string) {
+	return r.Next, r.Offset
+}
@@ -25,7 +25,7 @@ func (s *RoutesTestSuite) TestCreateReturnsHttpError() {
 
 	_, res, err := s.client.Routes.Create(route)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -51,7 +51,7 @@ func (s *RoutesTestSuite) TestCreate() {
 	route, res, err := s.client.Routes.Create(payload)
 
 	s.assert.IsType(&Route{}, route)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(route.Id)
@@ -72,7 +72,7 @@ func (s *RoutesTestSuite) TestListReturnsHttpError() {
 	client, _ := New(nil, s.server.URL)
 	_, res, err := client.Routes.List(nil)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -90,7 +90,7 @@ func (s *RoutesTestSuite) TestList() {
 	routes, res, err := s.client.Routes.List(nil)
 
 	s.assert.IsType(&Route{}, routes[0])
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(routes)
@@ -125,7 +125,7 @@ func (s *RoutesTestSuite) TestListWithOptions() {
 	routes, res, err := s.client.Routes.List(options)
 
 	s.assert.NotZero(routes)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
@@ -140,7 +140,7 @@ func (s *RoutesTestSuite) TestGetReturnsHttpError() {
 
 	_, res, err := s.client.Routes.Get("test-example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -160,7 +160,7 @@ func (s *RoutesTestSuite) TestGet() {
 	route, res, err := s.client.Routes.Get("2962c1d6b0e6")
 
 	s.assert.IsType(&Route{}, route)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(route.CreatedAt.Unix())
@@ -188,7 +188,7 @@ func (s *RoutesTestSuite) TestUpdateReturnsHttpError() {
 
 	_, res, err := s.client.Routes.Update("example", route)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -212,7 +212,7 @@ func (s *RoutesTestSuite) TestUpdate() {
 	route, res, err := s.client.Routes.Update("2962c1d6b0e6", payload)
 
 	s.assert.IsType(&Route{}, route)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(route.Id)
@@ -231,7 +231,7 @@ func (s *RoutesTestSuite) TestDeleteReturnsHttpError() {
 
 	res, err := s.client.Routes.Delete("example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -244,7 +244,7 @@ func (s *RoutesTestSuite) TestDelete() {
 
 	res, err := s.client.Routes.Delete("2962c1d6b0e6")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
@@ -0,0 +1,335 @@
+package kongo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+const (
+	schemasResourcePath = "/schemas"
+)
+
+type (
+	// Schemas retrieves the Kong entity and plugin schemas and validates payloads against them.
+	Schemas interface {
+		// Entity retrieves the schema of an entity, e.g. services.
+		Entity(name string) (*Schema, *Response, error)
+
+		// EntityWithContext retrieves the schema of an entity, e.g. services.
+		EntityWithContext(ctx context.Context, name string) (*Schema, *Response, error)
+
+		// Plugin retrieves the schema of a plugin, e.g. rate-limiting.
+		Plugin(name string) (*Schema, *Response, error)
+
+		// PluginWithContext retrieves the schema of a plugin, e.g. rate-limiting.
+		PluginWithContext(ctx context.Context, name string) (*Schema, *Response, error)
+
+		// Validate validates an entity payload without persisting it.
+		Validate(name string, payload interface{}) (*Response, error)
+
+		// ValidateWithContext validates an entity payload without persisting it.
+		ValidateWithContext(ctx context.Context, name string, payload interface{}) (*Response, error)
+
+		// ValidateService validates a service as sent on create without persisting it.
+		ValidateService(svc *Service) (*Response, error)
+
+		// ValidateServiceWithContext validates a service as sent on create without persisting it.
+		ValidateServiceWithContext(ctx context.Context, svc *Service) (*Response, error)
+
+		// ValidateRoute validates a route as sent on create without persisting it.
+		ValidateRoute(route *Route) (*Response, error)
+
+		// ValidateRouteWithContext validates a route as sent on create without persisting it.
+		ValidateRouteWithContext(ctx context.Context, route *Route) (*Response, error)
+
+		// ValidatePlugin validates a plugin configuration, e.g. {"name": "cors", "config": {...}}, without persisting it.
+		ValidatePlugin(plugin interface{}) (*Response, error)
+
+		// ValidatePluginWithContext validates a plugin configuration without persisting it.
+		ValidatePluginWithContext(ctx context.Context, plugin interface{}) (*Response, error)
+	}
+
+	// SchemasService it's a concrete instance of schemas.
+	SchemasService struct {
+		// Kongo client manages communication by API.
+		client *Kongo
+	}
+
+	// Schema it's a structure of API result.
+	Schema struct {
+		// The schema fields in declaration order.
+		Fields SchemaFields `json:"fields"`
+
+		// The checks involving several fields, e.g. at_least_one_of.
+		EntityChecks []json.RawMessage `json:"entity_checks,omitempty"`
+
+		// Attributes returned by Kong that are not mapped yet.
+		Extras map[string]json.RawMessage `json:"-"`
+	}
+
+	// SchemaFields it's a list of schema fields, represented by Kong as single key objects.
+	SchemaFields []*SchemaField
+
+	// SchemaField it's a field of an entity or plugin schema.
+	SchemaField struct {
+		// The field name.
+		Name string `json:"-"`
+
+		// The field type, e.g. string, integer, array, set, map or record.
+		Type string `json:"type"`
+
+		// Whether the field must be set.
+		Required bool `json:"required,omitempty"`
+
+		// Whether the value must be unique across the entities.
+		Unique bool `json:"unique,omitempty"`
+
+		// Whether the value is generated by Kong, e.g. ids and timestamps.
+		Auto bool `json:"auto,omitempty"`
+
+		// The value used when the field is not set.
+		Default json.RawMessage `json:"default,omitempty"`
+
+		// The accepted values.
+		OneOf []json.RawMessage `json:"one_of,omitempty"`
+
+		// The referenced entity of foreign fields, e.g. services.
+		Reference string `json:"reference,omitempty"`
+
+		// The schema of the array and set elements.
+		Elements *SchemaField `json:"elements,omitempty"`
+
+		// The schema of the map keys.
+		Keys *SchemaField `json:"keys,omitempty"`
+
+		// The schema of the map values.
+		Values *SchemaField `json:"values,omitempty"`
+
+		// The fields of records.
+		Fields SchemaFields `json:"fields,omitempty"`
+
+		// Attributes returned by Kong that are not mapped yet, e.g. len_min or match.
+		Extras map[string]json.RawMessage `json:"-"`
+	}
+)
+
+// EntityWithContext retrieves the schema of an entity, e.g. services.
+func (s *SchemasService) EntityWithContext(ctx context.Context, name string) (*Schema, *Response, error) {
+	ctx = withOperation(ctx, "Schemas", "Entity", "schema", name)
+
+	return s.get(ctx, path.Join(schemasResourcePath, name))
+}
+
+// Entity retrieves the schema of an entity, e.g. services.
+func (s *SchemasService) Entity(name string) (*Schema, *Response, error) {
+	return s.EntityWithContext(context.TODO(), name)
+}
+
+// PluginWithContext retrieves the schema of a plugin, e.g. rate-limiting.
+func (s *SchemasService) PluginWithContext(ctx context.Context, name string) (*Schema, *Response, error) {
+	ctx = withOperation(ctx, "Schemas", "Plugin", "schema", name)
+
+	return s.get(ctx, path.Join(schemasResourcePath, "plugins", name))
+}
+
+// Plugin retrieves the schema of a plugin, e.g. rate-limiting.
+func (s *SchemasService) Plugin(name string) (*Schema, *Response, error) {
+	return s.PluginWithContext(context.TODO(), name)
+}
+
+// ValidateWithContext validates an entity payload without persisting it.
+func (s *SchemasService) ValidateWithContext(ctx context.Context, name string, payload interface{}) (*Response, error) {
+	ctx = withOperation(ctx, "Schemas", "Validate", "schema", name)
+
+	return s.validate(ctx, name, payload)
+}
+
+// Validate validates an entity payload without persisting it.
+func (s *SchemasService) Validate(name string, payload interface{}) (*Response, error) {
+	return s.ValidateWithContext(context.TODO(), name, payload)
+}
+
+// ValidateServiceWithContext validates a service as sent on create without persisting it.
+func (s *SchemasService) ValidateServiceWithContext(ctx context.Context, svc *Service) (*Response, error) {
+	ctx = withOperation(ctx, "Schemas", "ValidateService", "schema", "services")
+
+	body, err := serviceBody(svc, "create")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(ctx, "services", body)
+}
+
+// ValidateService validates a service as sent on create without persisting it.
+func (s *SchemasService) ValidateService(svc *Service) (*Response, error) {
+	return s.ValidateServiceWithContext(context.TODO(), svc)
+}
+
+// ValidateRouteWithContext validates a route as sent on create without persisting it.
+func (s *SchemasService) ValidateRouteWithContext(ctx context.Context, route *Route) (*Response, error) {
+	ctx = withOperation(ctx, "Schemas", "ValidateRoute", "schema", "routes")
+
+	body, err := routeBody(route)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(ctx, "routes", body)
+}
+
+// ValidateRoute validates a route as sent on create without persisting it.
+func (s *SchemasService) ValidateRoute(route *Route) (*Response, error) {
+	return s.ValidateRouteWithContext(context.TODO(), route)
+}
+
+// ValidatePluginWithContext validates a plugin configuration without persisting it.
+func (s *SchemasService) ValidatePluginWithContext(ctx context.Context, plugin interface{}) (*Response, error) {
+	ctx = withOperation(ctx, "Schemas", "ValidatePlugin", "schema", "plugins")
+
+	return s.validate(ctx, "plugins", plugin)
+}
+
+// ValidatePlugin validates a plugin configuration, e.g. {"name": "cors", "config": {...}}, without persisting it.
+func (s *SchemasService) ValidatePlugin(plugin interface{}) (*Response, error) {
+	return s.ValidatePluginWithContext(context.TODO(), plugin)
+}
+
+// get retrieves a schema.
+func (s *SchemasService) get(ctx context.Context, resourcePath string) (*Schema, *Response, error) {
+	resource := &url.URL{Path: resourcePath}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, resource, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := new(Schema)
+
+	res, err := s.client.send(req, schema)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	return schema, res, nil
+}
+
+// validate posts the payload to the entity validation endpoint, schema violations are returned as ErrorResponse
+// with the invalid fields.
+func (s *SchemasService) validate(ctx context.Context, name string, payload interface{}) (*Response, error) {
+	resource := &url.URL{Path: path.Join(schemasResourcePath, name, "validate")}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, resource, payload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.send(req, nil)
+}
+
+// UnmarshalJSON unmarshals the schema keeping the attributes that are not mapped.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type schema Schema
+
+	if err := json.Unmarshal(data, (*schema)(s)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, s)
+
+	if err != nil {
+		return err
+	}
+
+	s.Extras = extras
+
+	return nil
+}
+
+// UnmarshalJSON unmarshals the list of single key objects into named fields.
+func (f *SchemaFields) UnmarshalJSON(data []byte) error {
+	var items []map[string]*SchemaField
+
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	fields := make(SchemaFields, 0, len(items))
+
+	for _, item := range items {
+		for name, field := range item {
+			if field == nil {
+				field = new(SchemaField)
+			}
+
+			field.Name = name
+			fields = append(fields, field)
+		}
+	}
+
+	*f = fields
+
+	return nil
+}
+
+// Field returns the field by name, nil when it is not declared.
+func (f SchemaFields) Field(name string) *SchemaField {
+	for _, field := range f {
+		if field.Name == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON unmarshals the field keeping the attributes that are not mapped.
+func (f *SchemaField) UnmarshalJSON(data []byte) error {
+	type schemaField SchemaField
+
+	if err := json.Unmarshal(data, (*schemaField)(f)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, f)
+
+	if err != nil {
+		return err
+	}
+
+	f.Extras = extras
+
+	return nil
+}
+
+// MarshalJSON marshals the named fields into the list of single key objects used by Kong.
+func (f SchemaFields) MarshalJSON() ([]byte, error) {
+	items := make([]map[string]*SchemaField, 0, len(f))
+
+	for _, field := range f {
+		items = append(items, map[string]*SchemaField{field.Name: field})
+	}
+
+	return json.Marshal(items)
+}
+
+// MarshalJSON marshals the field including the attributes that are not mapped.
+func (f SchemaField) MarshalJSON() ([]byte, error) {
+	type schemaField SchemaField
+
+	data, err := json.Marshal(schemaField(f))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeExtras(data, f.Extras)
+}
@@ -0,0 +1,184 @@
+package kongo
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"testing"
+)
+
+type SchemasTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *SchemasTestSuite) TestEntity() {
+	s.mux.HandleFunc(schemasResourcePath+"/services", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		file, _ := s.LoadFixture("fixtures/schemas_services.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	schema, res, err := s.client.Schemas.Entity("services")
+
+	s.assert.IsType(&Schema{}, schema)
+	s.assert.IsType(&Response{}, res)
+	s.assert.Nil(err)
+
+	s.assert.Len(schema.Fields, 11)
+	s.assert.Equal("id", schema.Fields[0].Name)
+	s.assert.True(schema.Fields[0].Auto)
+	s.assert.Len(schema.EntityChecks, 1)
+
+	protocol := schema.Fields.Field("protocol")
+
+	s.assert.True(protocol.Required)
+	s.assert.Equal(json.RawMessage(`"http"`), protocol.Default)
+	s.assert.Len(protocol.OneOf, 10)
+
+	s.assert.Equal(json.RawMessage(`[0, 65535]`), schema.Fields.Field("port").Extras["between"])
+	s.assert.Equal("string", schema.Fields.Field("tags").Elements.Type)
+	s.assert.Equal("certificates", schema.Fields.Field("client_certificate").Reference)
+	s.assert.Nil(schema.Fields.Field("url"))
+}
+
+func (s *SchemasTestSuite) TestEntityReturnsHttpError() {
+	s.mux.HandleFunc(schemasResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+
+		fmt.Fprint(w, `{"message": "No entity named 'foo'"}`)
+	})
+
+	schema, res, err := s.client.Schemas.Entity("foo")
+
+	s.assert.Nil(schema)
+	s.assert.IsType(&Response{}, res)
+	s.assert.EqualError(err, "404 No entity named 'foo'")
+}
+
+func (s *SchemasTestSuite) TestPlugin() {
+	s.mux.HandleFunc(schemasResourcePath+"/plugins/cors", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodGet, r.Method)
+
+		fmt.Fprint(w, `{"fields": [{"config": {"type": "record", "required": true, "fields": [{"max_age": {"type": "number"}}, {"credentials": {"type": "boolean", "default": false}}]}}]}`)
+	})
+
+	schema, _, err := s.client.Schemas.Plugin("cors")
+
+	s.assert.Nil(err)
+
+	config := schema.Fields.Field("config")
+
+	s.assert.Equal("record", config.Type)
+	s.assert.Equal("max_age", config.Fields[0].Name)
+	s.assert.Equal(json.RawMessage(`false`), config.Fields.Field("credentials").Default)
+}
+
+func (s *SchemasTestSuite) TestFieldsMarshalJSON() {
+	fields := SchemaFields{{Name: "port", Type: "integer", Extras: map[string]json.RawMessage{"between": json.RawMessage(`[0,65535]`)}}}
+
+	data, err := json.Marshal(fields)
+
+	s.assert.Nil(err)
+	s.assert.JSONEq(`[{"port": {"type": "integer", "between": [0, 65535]}}]`, string(data))
+}
+
+func (s *SchemasTestSuite) TestValidateService() {
+	s.mux.HandleFunc(schemasResourcePath+"/services/validate", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal("foo.org", body["host"])
+		s.assert.NotContains(body, "id")
+		s.assert.NotContains(body, "created_at")
+
+		fmt.Fprint(w, `{"message": "schema validation successful"}`)
+	})
+
+	res, err := s.client.Schemas.ValidateService(&Service{Name: "foo", Host: "foo.org"})
+
+	s.assert.IsType(&Response{}, res)
+	s.assert.Nil(err)
+}
+
+func (s *SchemasTestSuite) TestValidateRouteReturnsSchemaViolation() {
+	s.mux.HandleFunc(schemasResourcePath+"/routes/validate", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprint(w, `{"code": 2, "name": "schema violation", "message": "schema violation (paths.1: should start with: /)", "fields": {"paths": [null, "should start with: /"]}}`)
+	})
+
+	_, err := s.client.Schemas.ValidateRoute(&Route{Paths: []string{"/api", "v2"}})
+
+	s.assert.IsType(&ErrorResponse{}, err)
+
+	errResponse := err.(*ErrorResponse)
+
+	s.assert.Equal(2, errResponse.Code)
+	s.assert.Equal("schema violation", errResponse.Name)
+	s.assert.Equal([]interface{}{nil, "should start with: /"}, errResponse.Fields["paths"])
+}
+
+func (s *SchemasTestSuite) TestValidateRouteSendsCreateFields() {
+	s.mux.HandleFunc(schemasResourcePath+"/routes/validate", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal(map[string]interface{}{"paths": []interface{}{"/api"}, "service": map[string]interface{}{"id": "1"}}, body)
+
+		fmt.Fprint(w, `{"message": "schema validation successful"}`)
+	})
+
+	_, err := s.client.Schemas.ValidateRoute(&Route{Paths: []string{"/api"}, Service: RouteService{Id: "1"}})
+
+	s.assert.Nil(err)
+}
+
+func (s *SchemasTestSuite) TestValidateRouteKeepsFieldMasks() {
+	s.mux.HandleFunc(schemasResourcePath+"/routes/validate", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal(map[string]interface{}{"hosts": nil, "protocols": nil, "strip_path": false}, body)
+
+		fmt.Fprint(w, `{"message": "schema validation successful"}`)
+	})
+
+	_, err := s.client.Schemas.ValidateRoute(&Route{NullFields: []string{"Hosts", "Protocols"}, ForceSendFields: []string{"StripPath"}})
+
+	s.assert.Nil(err)
+}
+
+func (s *SchemasTestSuite) TestValidatePlugin() {
+	s.mux.HandleFunc(schemasResourcePath+"/plugins/validate", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal("cors", body["name"])
+
+		fmt.Fprint(w, `{"message": "schema validation successful"}`)
+	})
+
+	_, err := s.client.Schemas.ValidatePlugin(map[string]interface{}{"name": "cors", "config": map[string]interface{}{"max_age": 3600}})
+
+	s.assert.Nil(err)
+}
+
+func TestSchemasTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemasTestSuite))
+}
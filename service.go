@@ -2,61 +2,82 @@ package kongo
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"github.com/google/go-querystring/query"
 	"github.com/liip/sheriff"
+	"net"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
+	"strings"
 )
 
 const (
 	servicesResourcePath = "/services"
 )
 
+// defaultPorts stores the port used by Kong when the service URL has none, by protocol
+var defaultPorts = map[string]int{
+	"grpc":  80,
+	"grpcs": 443,
+	"http":  80,
+	"https": 443,
+	"ws":    80,
+	"wss":   443,
+}
+
 type (
 	// Services manages the Kong upstream services.
 	Services interface {
 		// Create creates a new service
-		Create(svc *Service) (*Service, *http.Response, error)
+		Create(svc *Service) (*Service, *Response, error)
 
 		// CreateWithContext creates a new service
-		CreateWithContext(ctx context.Context, svc *Service) (*Service, *http.Response, error)
+		CreateWithContext(ctx context.Context, svc *Service) (*Service, *Response, error)
 
 		// CreateByURLWithContext creates a new service by URL
-		CreateByURL(svc *Service) (*Service, *http.Response, error)
+		CreateByURL(svc *Service) (*Service, *Response, error)
 
 		// CreateByURLWithContext creates a new service by URL
-		CreateByURLWithContext(ctx context.Context, svc *Service) (*Service, *http.Response, error)
+		CreateByURLWithContext(ctx context.Context, svc *Service) (*Service, *Response, error)
 
 		// Delete deletes registred service by ID or Name
-		Delete(idOrName string) (*http.Response, error)
+		Delete(idOrName string) (*Response, error)
 
 		// DeleteWithContext deletes registred service by ID or Name
-		DeleteWithContext(ctx context.Context, idOrName string) (*http.Response, error)
+		DeleteWithContext(ctx context.Context, idOrName string) (*Response, error)
 
 		// Get retrieves registred service by ID or Name
-		Get(idOrName string) (*Service, *http.Response, error)
+		Get(idOrName string) (*Service, *Response, error)
 
 		// GetWithContext retrieves registred service by ID or Name
-		GetWithContext(ctx context.Context, idOrName string) (*Service, *http.Response, error)
+		GetWithContext(ctx context.Context, idOrName string) (*Service, *Response, error)
 
 		// List retrieves a list of registred services
-		List(options *ListServicesOptions) ([]*Service, *http.Response, error)
+		List(options *ListServicesOptions) ([]*Service, *Response, error)
 
 		// ListWithContext retrieves a list of registred services
-		ListWithContext(ctx context.Context, options *ListServicesOptions) ([]*Service, *http.Response, error)
+		ListWithContext(ctx context.Context, options *ListServicesOptions) ([]*Service, *Response, error)
 
 		// Update updates a service registred by ID or Name
-		Update(idOrName string, svc *Service) (*Service, *http.Response, error)
+		Update(idOrName string, svc *Service) (*Service, *Response, error)
 
 		// UpdateWithContext updates a service registred by ID or Name
-		UpdateWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *http.Response, error)
+		UpdateWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error)
 
 		// UpdateByURL updates a service registred by URL and pass the ID or Name
-		UpdateByURL(idOrName string, svc *Service) (*Service, *http.Response, error)
+		UpdateByURL(idOrName string, svc *Service) (*Service, *Response, error)
 
 		// UpdateByURLWithContext updates a service registred by URL and pass the ID or Name
-		UpdateByURLWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *http.Response, error)
+		UpdateByURLWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error)
+
+		// UpdateIfUnchanged updates a service registred by ID or Name when it was not changed since the seen version
+		UpdateIfUnchanged(idOrName string, seen *Service, svc *Service) (*Service, *Response, error)
+
+		// UpdateIfUnchangedWithContext updates a service registred by ID or Name when it was not changed since the seen version
+		UpdateIfUnchangedWithContext(ctx context.Context, idOrName string, seen *Service, svc *Service) (*Service, *Response, error)
 	}
 
 	// ServicesService it's a concrete instance of service
@@ -98,6 +119,9 @@ type (
 		// The number of retries to execute upon failure to proxy. The default is 5.
 		Retries int `json:"retries,omitempty" groups:"create,update"`
 
+		// An optional set of strings associated with the service, for grouping and filtering. Requires Kong 1.1 or later.
+		Tags []string `json:"tags,omitempty" groups:"create,create_url,update,update_url"`
+
 		// The date when the service was updated
 		UpdatedAt Time `json:"updated_at"`
 
@@ -106,11 +130,26 @@ type (
 
 		// The timeout in milliseconds between two successive write operations for transmitting a request to the upstream server. Defaults to 60000.
 		WriteTimeout int `json:"write_timeout,omitempty" groups:"create,update"`
+
+		// Fields returned by Kong that are not mapped yet, re-emitted when the service is marshalled.
+		Extras map[string]json.RawMessage `json:"-"`
+
+		// Field names, e.g. Retries, sent on create and update even when they hold the zero value.
+		ForceSendFields []string `json:"-"`
+
+		// Field names, e.g. Path, sent as null on create and update, clearing their values.
+		NullFields []string `json:"-"`
 	}
 
 	// ServicesRoot it's a structure of API result list
 	ServicesRoot struct {
 		Services []*Service `json:"data"`
+
+		// Path of the next page, empty on the last page
+		Next string `json:"next,omitempty"`
+
+		// Cursor of the next page
+		Offset string `json:"offset,omitempty"`
 	}
 
 	// ListServicesOptions stores the options you can set for requesting the service list
@@ -123,12 +162,37 @@ type (
 	}
 )
 
+// serviceBody returns the request body with the service attributes of the group
+func serviceBody(svc *Service, groupName string) (interface{}, error) {
+	opts := &sheriff.Options{Groups: []string{groupName}}
+	body, err := sheriff.Marshal(opts, svc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := fieldMaskValues(svc, svc.ForceSendFields, svc.NullFields, groupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeExtrasMap(body, svc.Extras, fields), nil
+}
+
 // create creates a new service
-func (s *ServicesService) create(ctx context.Context, svc *Service, groupName string) (*Service, *http.Response, error) {
+func (s *ServicesService) create(ctx context.Context, svc *Service, groupName string) (*Service, *Response, error) {
 	resource, _ := url.Parse(servicesResourcePath)
 
-	opts := &sheriff.Options{Groups: []string{groupName}}
-	body, err := sheriff.Marshal(opts, svc)
+	if err := s.client.require(ctx, svc.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.client.validateService(svc, groupName, false); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := serviceBody(svc, groupName)
 
 	if err != nil {
 		return nil, nil, err
@@ -142,7 +206,7 @@ func (s *ServicesService) create(ctx context.Context, svc *Service, groupName st
 
 	root := new(Service)
 
-	res, err := s.client.Do(req, root)
+	res, err := s.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -152,27 +216,33 @@ func (s *ServicesService) create(ctx context.Context, svc *Service, groupName st
 }
 
 // CreateWithContext creates a new service
-func (s *ServicesService) CreateWithContext(ctx context.Context, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) CreateWithContext(ctx context.Context, svc *Service) (*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "Create", "service", "")
+
 	return s.create(ctx, svc, "create")
 }
 
 // Create creates a new service
-func (s *ServicesService) Create(svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) Create(svc *Service) (*Service, *Response, error) {
 	return s.CreateWithContext(context.TODO(), svc)
 }
 
 // CreateByURLWithContext creates a new service by URL
-func (s *ServicesService) CreateByURLWithContext(ctx context.Context, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) CreateByURLWithContext(ctx context.Context, svc *Service) (*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "CreateByURL", "service", "")
+
 	return s.create(ctx, svc, "create_url")
 }
 
 // CreateByURL creates a new service by URL
-func (s *ServicesService) CreateByURL(svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) CreateByURL(svc *Service) (*Service, *Response, error) {
 	return s.CreateByURLWithContext(context.TODO(), svc)
 }
 
 // DeleteWithContext retrieves registred service by ID or Name
-func (s *ServicesService) DeleteWithContext(ctx context.Context, idOrName string) (*http.Response, error) {
+func (s *ServicesService) DeleteWithContext(ctx context.Context, idOrName string) (*Response, error) {
+	ctx = withOperation(ctx, "Services", "Delete", "service", idOrName)
+
 	resource, _ := url.Parse(servicesResourcePath)
 	resource.Path = path.Join(resource.Path, idOrName)
 
@@ -182,7 +252,7 @@ func (s *ServicesService) DeleteWithContext(ctx context.Context, idOrName string
 		return nil, err
 	}
 
-	res, err := s.client.Do(req, nil)
+	res, err := s.client.send(req, nil)
 
 	if err != nil {
 		return res, err
@@ -192,12 +262,14 @@ func (s *ServicesService) DeleteWithContext(ctx context.Context, idOrName string
 }
 
 // Delete retrieves registred service by ID or Name
-func (s *ServicesService) Delete(idOrName string) (*http.Response, error) {
+func (s *ServicesService) Delete(idOrName string) (*Response, error) {
 	return s.DeleteWithContext(context.TODO(), idOrName)
 }
 
 // GetWithContext retrieves registred service by ID or Name
-func (s *ServicesService) GetWithContext(ctx context.Context, idOrName string) (*Service, *http.Response, error) {
+func (s *ServicesService) GetWithContext(ctx context.Context, idOrName string) (*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "Get", "service", idOrName)
+
 	resource, _ := url.Parse(servicesResourcePath)
 	resource.Path = path.Join(resource.Path, idOrName)
 
@@ -209,7 +281,7 @@ func (s *ServicesService) GetWithContext(ctx context.Context, idOrName string) (
 
 	svc := new(Service)
 
-	res, err := s.client.Do(req, svc)
+	res, err := s.client.send(req, svc)
 
 	if err != nil {
 		return nil, res, err
@@ -219,12 +291,14 @@ func (s *ServicesService) GetWithContext(ctx context.Context, idOrName string) (
 }
 
 // Get retrieves registred service by ID or Name
-func (s *ServicesService) Get(idOrName string) (*Service, *http.Response, error) {
+func (s *ServicesService) Get(idOrName string) (*Service, *Response, error) {
 	return s.GetWithContext(context.TODO(), idOrName)
 }
 
 // ListWithContext retrieves a list of registred services
-func (s *ServicesService) ListWithContext(ctx context.Context, options *ListServicesOptions) ([]*Service, *http.Response, error) {
+func (s *ServicesService) ListWithContext(ctx context.Context, options *ListServicesOptions) ([]*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "List", "service", "")
+
 	opts, _ := query.Values(options)
 	resource, _ := url.Parse(servicesResourcePath)
 	resource.RawQuery = opts.Encode()
@@ -237,7 +311,7 @@ func (s *ServicesService) ListWithContext(ctx context.Context, options *ListServ
 
 	root := new(ServicesRoot)
 
-	res, err := s.client.Do(req, root)
+	res, err := s.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -247,17 +321,24 @@ func (s *ServicesService) ListWithContext(ctx context.Context, options *ListServ
 }
 
 // List retrieves a list of registred services
-func (s *ServicesService) List(options *ListServicesOptions) ([]*Service, *http.Response, error) {
+func (s *ServicesService) List(options *ListServicesOptions) ([]*Service, *Response, error) {
 	return s.ListWithContext(context.TODO(), options)
 }
 
 // update updates a service registred
-func (s *ServicesService) update(ctx context.Context, idOrName string, svc *Service, groupName string) (*Service, *http.Response, error) {
+func (s *ServicesService) update(ctx context.Context, idOrName string, svc *Service, groupName string) (*Service, *Response, error) {
 	resource, _ := url.Parse(servicesResourcePath)
 	resource.Path = path.Join(resource.Path, idOrName)
 
-	opts := &sheriff.Options{Groups: []string{groupName}}
-	body, err := sheriff.Marshal(opts, svc)
+	if err := s.client.require(ctx, svc.capabilities()...); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.client.validateService(svc, groupName, true); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := serviceBody(svc, groupName)
 
 	if err != nil {
 		return nil, nil, err
@@ -271,7 +352,7 @@ func (s *ServicesService) update(ctx context.Context, idOrName string, svc *Serv
 
 	root := new(Service)
 
-	res, err := s.client.Do(req, root)
+	res, err := s.client.send(req, root)
 
 	if err != nil {
 		return nil, res, err
@@ -281,21 +362,191 @@ func (s *ServicesService) update(ctx context.Context, idOrName string, svc *Serv
 }
 
 // UpdateWithContext updates a service
-func (s *ServicesService) UpdateWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) UpdateWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "Update", "service", idOrName)
+
 	return s.update(ctx, idOrName, svc, "update")
 }
 
 // Update updates a service
-func (s *ServicesService) Update(idOrName string, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) Update(idOrName string, svc *Service) (*Service, *Response, error) {
 	return s.UpdateWithContext(context.TODO(), idOrName, svc)
 }
 
 // UpdateByURLWithContext updates a service registred by URL and pass the ID or Name
-func (s *ServicesService) UpdateByURLWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) UpdateByURLWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error) {
+	ctx = withOperation(ctx, "Services", "UpdateByURL", "service", idOrName)
+
 	return s.update(ctx, idOrName, svc, "update_url")
 }
 
 // UpdateByURL updates a service registred by URL and pass the ID or Name
-func (s *ServicesService) UpdateByURL(idOrName string, svc *Service) (*Service, *http.Response, error) {
+func (s *ServicesService) UpdateByURL(idOrName string, svc *Service) (*Service, *Response, error) {
 	return s.UpdateByURLWithContext(context.TODO(), idOrName, svc)
 }
+
+// UpstreamURL builds the service URL from the protocol, host, port and path, omitting the default port
+func (s *Service) UpstreamURL() string {
+	protocol := s.Protocol
+
+	if protocol == "" {
+		protocol = "http"
+	}
+
+	host := s.Host
+
+	if s.Port != 0 && s.Port != defaultPorts[protocol] {
+		host = net.JoinHostPort(host, strconv.Itoa(s.Port))
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	return protocol + "://" + host + s.Path
+}
+
+// ParseURL sets the protocol, host, port and path from the service URL, the port defaults by protocol
+func (s *Service) ParseURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme == "" || u.Hostname() == "" {
+		return errors.New("Invalid service URL " + rawURL)
+	}
+
+	port := defaultPorts[u.Scheme]
+
+	if u.Port() != "" {
+		port, err = strconv.Atoi(u.Port())
+
+		if err != nil {
+			return err
+		}
+	}
+
+	s.Protocol = u.Scheme
+	s.Host = u.Hostname()
+	s.Port = port
+	s.Path = u.EscapedPath()
+
+	return nil
+}
+
+// capabilities returns the Kong capabilities needed by the service attributes.
+func (s *Service) capabilities() []Capability {
+	if s == nil || len(s.Tags) == 0 {
+		return nil
+	}
+
+	return []Capability{CapabilityTags}
+}
+
+// UpdateIfUnchangedWithContext re-reads the service and updates it only when it matches the seen version,
+// returning ErrConflict otherwise. Kong has no conditional requests, so a write between the read and the
+// update is still possible, the check only narrows that window.
+func (s *ServicesService) UpdateIfUnchangedWithContext(ctx context.Context, idOrName string, seen *Service, svc *Service) (*Service, *Response, error) {
+	current, res, err := s.GetWithContext(ctx, idOrName)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	ok, err := unchanged(seen.UpdatedAt, current.UpdatedAt, seen, current)
+
+	if err != nil {
+		return nil, res, err
+	}
+
+	if !ok {
+		return nil, res, ErrConflict
+	}
+
+	return s.UpdateWithContext(ctx, idOrName, svc)
+}
+
+// UpdateIfUnchanged updates a service registred by ID or Name when it was not changed since the seen version
+func (s *ServicesService) UpdateIfUnchanged(idOrName string, seen *Service, svc *Service) (*Service, *Response, error) {
+	return s.UpdateIfUnchangedWithContext(context.TODO(), idOrName, seen, svc)
+}
+
+// ModifyService reads the service, applies the mutation on a copy and updates it when unchanged, starting
+// over on conflicts up to the given attempts.
+func ModifyService(ctx context.Context, services Services, idOrName string, attempts int, mutate func(svc *Service) error) (*Service, *Response, error) {
+	var (
+		res *Response
+		err error
+	)
+
+	for i := 0; i < attempts; i++ {
+		var seen *Service
+
+		seen, res, err = services.GetWithContext(ctx, idOrName)
+
+		if err != nil {
+			return nil, res, err
+		}
+
+		svc := new(Service)
+
+		if err = deepCopy(seen, svc); err != nil {
+			return nil, res, err
+		}
+
+		if err = mutate(svc); err != nil {
+			return nil, res, err
+		}
+
+		svc, res, err = services.UpdateIfUnchangedWithContext(ctx, idOrName, seen, svc)
+
+		if err != ErrConflict {
+			return svc, res, err
+		}
+	}
+
+	return nil, res, ErrConflict
+}
+
+// UnmarshalJSON unmarshals the service keeping the fields that are not mapped
+func (s *Service) UnmarshalJSON(data []byte) error {
+	type service Service
+
+	if err := json.Unmarshal(data, (*service)(s)); err != nil {
+		return err
+	}
+
+	extras, err := unknownFields(data, s)
+
+	if err != nil {
+		return err
+	}
+
+	s.Extras = extras
+
+	return nil
+}
+
+// MarshalJSON marshals the service including the fields that are not mapped
+func (s Service) MarshalJSON() ([]byte, error) {
+	type service Service
+
+	data, err := json.Marshal(service(s))
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = mergeExtras(data, s.Extras)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return applyFieldMasks(data, s, s.ForceSendFields, s.NullFields)
+}
+
+// page returns the next page cursor
+func (s *ServicesRoot) page() (string, string) {
+	return s.Next, s.Offset
+}
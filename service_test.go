@@ -25,7 +25,7 @@ func (s *ServicesTestSuite) TestCreateReturnsHttpError() {
 
 	_, res, err := s.client.Services.Create(svc)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -52,7 +52,7 @@ func (s *ServicesTestSuite) TestCreate() {
 	svc, res, err := s.client.Services.Create(payload)
 
 	s.assert.IsType(&Service{}, svc)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(svc.Id)
@@ -81,7 +81,7 @@ func (s *ServicesTestSuite) TestCreateByURL() {
 	svc, res, err := s.client.Services.CreateByURL(payload)
 
 	s.assert.IsType(&Service{}, svc)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(svc.Id)
@@ -105,7 +105,7 @@ func (s *ServicesTestSuite) TestListReturnsHttpError() {
 	client, _ := New(nil, s.server.URL)
 	_, res, err := client.Services.List(nil)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -123,7 +123,7 @@ func (s *ServicesTestSuite) TestList() {
 	services, res, err := s.client.Services.List(nil)
 
 	s.assert.IsType(&Service{}, services[0])
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotZero(services)
@@ -160,7 +160,7 @@ func (s *ServicesTestSuite) TestListWithOptions() {
 	services, res, err := s.client.Services.List(options)
 
 	s.assert.NotZero(services)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
@@ -175,7 +175,7 @@ func (s *ServicesTestSuite) TestGetReturnsHttpError() {
 
 	_, res, err := s.client.Services.Get("test-example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -195,7 +195,7 @@ func (s *ServicesTestSuite) TestGet() {
 	svc, res, err := s.client.Services.Get("bar-s")
 
 	s.assert.IsType(&Service{}, svc)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(svc.Id)
@@ -220,7 +220,7 @@ func (s *ServicesTestSuite) TestUpdateReturnsHttpError() {
 
 	_, res, err := s.client.Services.Update("example", svc)
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -246,7 +246,7 @@ func (s *ServicesTestSuite) TestUpdate() {
 	svc, res, err := s.client.Services.Update("bar-s", payload)
 
 	s.assert.IsType(&Service{}, svc)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(svc.Id)
@@ -275,7 +275,7 @@ func (s *ServicesTestSuite) TestUpdateByURL() {
 	svc, res, err := s.client.Services.UpdateByURL("bar-s", payload)
 
 	s.assert.IsType(&Service{}, svc)
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 
 	s.assert.NotEmpty(svc.Id)
@@ -298,7 +298,7 @@ func (s *ServicesTestSuite) TestDeleteReturnsHttpError() {
 
 	res, err := s.client.Services.Delete("example")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Error(err)
 }
 
@@ -311,10 +311,46 @@ func (s *ServicesTestSuite) TestDelete() {
 
 	res, err := s.client.Services.Delete("bar-s")
 
-	s.assert.IsType(&http.Response{}, res)
+	s.assert.IsType(&Response{}, res)
 	s.assert.Nil(err)
 }
 
+func (s *ServicesTestSuite) TestUpstreamURL() {
+	s.assert.Equal("http://foo.org", (&Service{Host: "foo.org"}).UpstreamURL())
+	s.assert.Equal("https://foo.org/api", (&Service{Protocol: "https", Host: "foo.org", Port: 443, Path: "/api"}).UpstreamURL())
+	s.assert.Equal("http://foo.org:8080/api", (&Service{Protocol: "http", Host: "foo.org", Port: 8080, Path: "/api"}).UpstreamURL())
+	s.assert.Equal("tcp://10.0.0.1:5432", (&Service{Protocol: "tcp", Host: "10.0.0.1", Port: 5432}).UpstreamURL())
+	s.assert.Equal("http://[::1]", (&Service{Host: "::1", Port: 80}).UpstreamURL())
+	s.assert.Equal("https://[::1]:8443", (&Service{Protocol: "https", Host: "::1", Port: 8443}).UpstreamURL())
+}
+
+func (s *ServicesTestSuite) TestParseURL() {
+	svc := &Service{}
+
+	s.assert.Nil(svc.ParseURL("https://foo.org/api/v1"))
+	s.assert.Equal("https", svc.Protocol)
+	s.assert.Equal("foo.org", svc.Host)
+	s.assert.Equal(443, svc.Port)
+	s.assert.Equal("/api/v1", svc.Path)
+
+	s.assert.Nil(svc.ParseURL("http://[::1]:8080"))
+	s.assert.Equal("http", svc.Protocol)
+	s.assert.Equal("::1", svc.Host)
+	s.assert.Equal(8080, svc.Port)
+	s.assert.Equal("", svc.Path)
+
+	s.assert.EqualError(svc.ParseURL("foo.org/api"), "Invalid service URL foo.org/api")
+	s.assert.Error(svc.ParseURL("http://foo.org:port"))
+}
+
+func (s *ServicesTestSuite) TestURLRoundTrip() {
+	byURL := &Service{}
+
+	s.assert.Nil(byURL.ParseURL("https://foo.org:443/api"))
+	s.assert.Equal("https://foo.org/api", byURL.UpstreamURL())
+	s.assert.Equal(byURL.UpstreamURL(), (&Service{Protocol: "https", Host: "foo.org", Path: "/api"}).UpstreamURL())
+}
+
 func TestServicesTestSuite(t *testing.T) {
 	suite.Run(t, new(ServicesTestSuite))
 }
@@ -0,0 +1,133 @@
+package kongo
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Skew it's a node information field whose value differs across the nodes.
+	Skew struct {
+		// The field name, e.g. version.
+		Field string
+
+		// The field value by node.
+		Values map[string]string
+	}
+
+	// skewField extracts a comparable value from the node information.
+	skewField struct {
+		name  string
+		value func(info *NodeInfo) string
+	}
+)
+
+// skewFields are the node information fields compared across the nodes.
+var skewFields = []skewField{
+	{"version", func(info *NodeInfo) string {
+		return info.Version
+	}},
+	{"plugins.available_on_server", func(info *NodeInfo) string {
+		if info.Plugins == nil {
+			return ""
+		}
+
+		return joinEnabled(info.Plugins.AvailableOnServer)
+	}},
+	{"plugins.enabled_in_cluster", func(info *NodeInfo) string {
+		if info.Plugins == nil {
+			return ""
+		}
+
+		return joinSorted(info.Plugins.EnabledInCluster)
+	}},
+	{"database", func(info *NodeInfo) string {
+		if info.Configuration == nil {
+			return ""
+		}
+
+		return info.Configuration.Database
+	}},
+	{"db_cache_ttl", func(info *NodeInfo) string {
+		if info.Configuration == nil {
+			return ""
+		}
+
+		return strconv.Itoa(info.Configuration.DatabaseCacheTTL)
+	}},
+	{"admin_listen", func(info *NodeInfo) string {
+		if info.Configuration == nil {
+			return ""
+		}
+
+		return joinSorted(info.Configuration.AdminListen)
+	}},
+	{"proxy_listen", func(info *NodeInfo) string {
+		if info.Configuration == nil {
+			return ""
+		}
+
+		return joinSorted(info.Configuration.ProxyListen)
+	}},
+}
+
+// DetectSkew compares the information of the nodes, keyed by node name, and returns the fields whose
+// values differ: Kong version, plugin sets, database settings and listeners. Nil entries are ignored.
+func DetectSkew(nodes map[string]*NodeInfo) []*Skew {
+	skews := []*Skew{}
+
+	for _, field := range skewFields {
+		values := map[string]string{}
+		distinct := map[string]bool{}
+
+		for name, info := range nodes {
+			if info == nil {
+				continue
+			}
+
+			value := field.value(info)
+			values[name] = value
+			distinct[value] = true
+		}
+
+		if len(distinct) > 1 {
+			skews = append(skews, &Skew{Field: field.name, Values: values})
+		}
+	}
+
+	return skews
+}
+
+// Skew compares the information of the inspected nodes, keyed by admin URL.
+func (r *ClusterReport) Skew() []*Skew {
+	nodes := map[string]*NodeInfo{}
+
+	for _, node := range r.Nodes {
+		nodes[node.Client.BaseURL.String()] = node.Info
+	}
+
+	return DetectSkew(nodes)
+}
+
+// joinEnabled returns the sorted names of the available plugins, comma separated.
+func joinEnabled(plugins map[string]NodeInfoAvailablePlugin) string {
+	keys := []string{}
+
+	for key, plugin := range plugins {
+		if plugin.Available {
+			keys = append(keys, key)
+		}
+	}
+
+	return joinSorted(keys)
+}
+
+// joinSorted returns a sorted copy of the values, comma separated.
+func joinSorted(values []string) string {
+	sorted := append([]string{}, values...)
+
+	sort.Strings(sorted)
+
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,75 @@
+package kongo
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type SkewTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *SkewTestSuite) TestWithoutSkew() {
+	skews := DetectSkew(map[string]*NodeInfo{
+		"a": s.nodeInfo(),
+		"b": s.nodeInfo(),
+		"c": nil,
+	})
+
+	s.assert.Empty(skews)
+}
+
+func (s *SkewTestSuite) TestDetectSkew() {
+	upgraded := s.nodeInfo()
+	upgraded.Version = "0.14.0"
+	upgraded.Plugins.AvailableOnServer["zipkin"] = NodeInfoAvailablePlugin{Available: true}
+	upgraded.Plugins.EnabledInCluster = append(upgraded.Plugins.EnabledInCluster, "zipkin")
+	upgraded.Configuration.DatabaseCacheTTL = 60
+	upgraded.Configuration.AdminListen = []string{"127.0.0.1:8444 ssl"}
+
+	current := s.nodeInfo()
+
+	skews := DetectSkew(map[string]*NodeInfo{"a": current, "b": upgraded})
+
+	fields := []string{}
+
+	for _, skew := range skews {
+		fields = append(fields, skew.Field)
+	}
+
+	s.assert.Equal(
+		[]string{"version", "plugins.available_on_server", "plugins.enabled_in_cluster", "db_cache_ttl", "admin_listen"},
+		fields,
+	)
+	s.assert.Equal(map[string]string{"a": current.Version, "b": "0.14.0"}, skews[0].Values)
+	s.assert.Equal("key-auth,zipkin", skews[2].Values["b"])
+	s.assert.Equal("60", skews[3].Values["b"])
+}
+
+func (s *SkewTestSuite) TestClusterReportSkew() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "0.14.0"}`)
+	}))
+
+	defer server.Close()
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "0.13.0"}`)
+	})
+
+	cluster, _ := NewCluster(nil, []string{s.server.URL, server.URL}, 0)
+	skews := cluster.Inspect(context.Background()).Skew()
+
+	s.assert.Len(skews, 1)
+	s.assert.Equal("version", skews[0].Field)
+	s.assert.Equal("0.13.0", skews[0].Values[s.server.URL])
+	s.assert.Equal("0.14.0", skews[0].Values[server.URL])
+}
+
+func TestSkewTestSuite(t *testing.T) {
+	suite.Run(t, new(SkewTestSuite))
+}
@@ -0,0 +1,257 @@
+package kongo
+
+import (
+	"context"
+	v1 "github.com/fabiorphp/kongo"
+)
+
+// The adapters implement the v1 interfaces.
+var (
+	_ v1.Services  = (*ServicesAdapter)(nil)
+	_ v1.Routes    = (*RoutesAdapter)(nil)
+	_ v1.Customers = (*CustomersAdapter)(nil)
+	_ v1.Node      = (*NodeAdapter)(nil)
+)
+
+type (
+	// ServicesAdapter implements the v1 Services interface on top of the v2 one, helping code that depends on
+	// v1 to migrate gradually. Methods without context use context.Background.
+	ServicesAdapter struct {
+		Services Services
+	}
+
+	// RoutesAdapter implements the v1 Routes interface on top of the v2 one.
+	RoutesAdapter struct {
+		Routes Routes
+	}
+
+	// CustomersAdapter implements the v1 Customers interface on top of the v2 one.
+	CustomersAdapter struct {
+		Customers Customers
+	}
+
+	// NodeAdapter implements the v1 Node interface on top of the v2 one.
+	NodeAdapter struct {
+		Node Node
+	}
+)
+
+// V1 returns the client services implementing the v1 interfaces.
+func (c *Client) V1() (v1.Services, v1.Routes, v1.Customers, v1.Node) {
+	return &ServicesAdapter{c.Services}, &RoutesAdapter{c.Routes}, &CustomersAdapter{c.Customers}, &NodeAdapter{c.Node}
+}
+
+// Create creates a new service.
+func (a *ServicesAdapter) Create(svc *Service) (*Service, *Response, error) {
+	return a.Services.Create(context.Background(), svc)
+}
+
+// CreateWithContext creates a new service.
+func (a *ServicesAdapter) CreateWithContext(ctx context.Context, svc *Service) (*Service, *Response, error) {
+	return a.Services.Create(ctx, svc)
+}
+
+// CreateByURL creates a new service by URL.
+func (a *ServicesAdapter) CreateByURL(svc *Service) (*Service, *Response, error) {
+	return a.Services.CreateByURL(context.Background(), svc)
+}
+
+// CreateByURLWithContext creates a new service by URL.
+func (a *ServicesAdapter) CreateByURLWithContext(ctx context.Context, svc *Service) (*Service, *Response, error) {
+	return a.Services.CreateByURL(ctx, svc)
+}
+
+// Delete deletes a service registered by ID or Name.
+func (a *ServicesAdapter) Delete(idOrName string) (*Response, error) {
+	return a.Services.Delete(context.Background(), idOrName)
+}
+
+// DeleteWithContext deletes a service registered by ID or Name.
+func (a *ServicesAdapter) DeleteWithContext(ctx context.Context, idOrName string) (*Response, error) {
+	return a.Services.Delete(ctx, idOrName)
+}
+
+// Get retrieves a service registered by ID or Name.
+func (a *ServicesAdapter) Get(idOrName string) (*Service, *Response, error) {
+	return a.Services.Get(context.Background(), idOrName)
+}
+
+// GetWithContext retrieves a service registered by ID or Name.
+func (a *ServicesAdapter) GetWithContext(ctx context.Context, idOrName string) (*Service, *Response, error) {
+	return a.Services.Get(ctx, idOrName)
+}
+
+// List retrieves a list of registered services.
+func (a *ServicesAdapter) List(options *ListServicesOptions) ([]*Service, *Response, error) {
+	return a.Services.List(context.Background(), options)
+}
+
+// ListWithContext retrieves a list of registered services.
+func (a *ServicesAdapter) ListWithContext(ctx context.Context, options *ListServicesOptions) ([]*Service, *Response, error) {
+	return a.Services.List(ctx, options)
+}
+
+// Update updates a service registered by ID or Name.
+func (a *ServicesAdapter) Update(idOrName string, svc *Service) (*Service, *Response, error) {
+	return a.Services.Update(context.Background(), idOrName, svc)
+}
+
+// UpdateWithContext updates a service registered by ID or Name.
+func (a *ServicesAdapter) UpdateWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error) {
+	return a.Services.Update(ctx, idOrName, svc)
+}
+
+// UpdateByURL updates a service registered by ID or Name through its URL.
+func (a *ServicesAdapter) UpdateByURL(idOrName string, svc *Service) (*Service, *Response, error) {
+	return a.Services.UpdateByURL(context.Background(), idOrName, svc)
+}
+
+// UpdateByURLWithContext updates a service registered by ID or Name through its URL.
+func (a *ServicesAdapter) UpdateByURLWithContext(ctx context.Context, idOrName string, svc *Service) (*Service, *Response, error) {
+	return a.Services.UpdateByURL(ctx, idOrName, svc)
+}
+
+// UpdateIfUnchanged updates a service registered by ID or Name when it was not changed since the seen version.
+func (a *ServicesAdapter) UpdateIfUnchanged(idOrName string, seen *Service, svc *Service) (*Service, *Response, error) {
+	return a.Services.UpdateIfUnchanged(context.Background(), idOrName, seen, svc)
+}
+
+// UpdateIfUnchangedWithContext updates a service registered by ID or Name when it was not changed since the seen version.
+func (a *ServicesAdapter) UpdateIfUnchangedWithContext(ctx context.Context, idOrName string, seen *Service, svc *Service) (*Service, *Response, error) {
+	return a.Services.UpdateIfUnchanged(ctx, idOrName, seen, svc)
+}
+
+// Create creates a new route.
+func (a *RoutesAdapter) Create(route *Route) (*Route, *Response, error) {
+	return a.Routes.Create(context.Background(), route)
+}
+
+// CreateWithContext creates a new route.
+func (a *RoutesAdapter) CreateWithContext(ctx context.Context, route *Route) (*Route, *Response, error) {
+	return a.Routes.Create(ctx, route)
+}
+
+// Delete deletes a route registered by ID.
+func (a *RoutesAdapter) Delete(id string) (*Response, error) {
+	return a.Routes.Delete(context.Background(), id)
+}
+
+// DeleteWithContext deletes a route registered by ID.
+func (a *RoutesAdapter) DeleteWithContext(ctx context.Context, id string) (*Response, error) {
+	return a.Routes.Delete(ctx, id)
+}
+
+// Get retrieves a route registered by ID.
+func (a *RoutesAdapter) Get(id string) (*Route, *Response, error) {
+	return a.Routes.Get(context.Background(), id)
+}
+
+// GetWithContext retrieves a route registered by ID.
+func (a *RoutesAdapter) GetWithContext(ctx context.Context, id string) (*Route, *Response, error) {
+	return a.Routes.Get(ctx, id)
+}
+
+// List retrieves a list of registered routes.
+func (a *RoutesAdapter) List(options *ListRoutesOptions) ([]*Route, *Response, error) {
+	return a.Routes.List(context.Background(), options)
+}
+
+// ListWithContext retrieves a list of registered routes.
+func (a *RoutesAdapter) ListWithContext(ctx context.Context, options *ListRoutesOptions) ([]*Route, *Response, error) {
+	return a.Routes.List(ctx, options)
+}
+
+// Update updates a route registered by ID.
+func (a *RoutesAdapter) Update(id string, route *Route) (*Route, *Response, error) {
+	return a.Routes.Update(context.Background(), id, route)
+}
+
+// UpdateWithContext updates a route registered by ID.
+func (a *RoutesAdapter) UpdateWithContext(ctx context.Context, id string, route *Route) (*Route, *Response, error) {
+	return a.Routes.Update(ctx, id, route)
+}
+
+// UpdateIfUnchanged updates a route registered by ID when it was not changed since the seen version.
+func (a *RoutesAdapter) UpdateIfUnchanged(id string, seen *Route, route *Route) (*Route, *Response, error) {
+	return a.Routes.UpdateIfUnchanged(context.Background(), id, seen, route)
+}
+
+// UpdateIfUnchangedWithContext updates a route registered by ID when it was not changed since the seen version.
+func (a *RoutesAdapter) UpdateIfUnchangedWithContext(ctx context.Context, id string, seen *Route, route *Route) (*Route, *Response, error) {
+	return a.Routes.UpdateIfUnchanged(ctx, id, seen, route)
+}
+
+// Create creates a new customer.
+func (a *CustomersAdapter) Create(customer *Customer) (*Customer, *Response, error) {
+	return a.Customers.Create(context.Background(), customer)
+}
+
+// CreateWithContext creates a new customer.
+func (a *CustomersAdapter) CreateWithContext(ctx context.Context, customer *Customer) (*Customer, *Response, error) {
+	return a.Customers.Create(ctx, customer)
+}
+
+// Delete deletes a customer registered by ID or Username.
+func (a *CustomersAdapter) Delete(idOrUsername string) (*Response, error) {
+	return a.Customers.Delete(context.Background(), idOrUsername)
+}
+
+// DeleteWithContext deletes a customer registered by ID or Username.
+func (a *CustomersAdapter) DeleteWithContext(ctx context.Context, idOrUsername string) (*Response, error) {
+	return a.Customers.Delete(ctx, idOrUsername)
+}
+
+// Get retrieves a customer registered by ID or Username.
+func (a *CustomersAdapter) Get(idOrUsername string) (*Customer, *Response, error) {
+	return a.Customers.Get(context.Background(), idOrUsername)
+}
+
+// GetWithContext retrieves a customer registered by ID or Username.
+func (a *CustomersAdapter) GetWithContext(ctx context.Context, idOrUsername string) (*Customer, *Response, error) {
+	return a.Customers.Get(ctx, idOrUsername)
+}
+
+// List retrieves a list of registered customers.
+func (a *CustomersAdapter) List(options *ListCustomersOptions) ([]*Customer, *Response, error) {
+	return a.Customers.List(context.Background(), options)
+}
+
+// ListWithContext retrieves a list of registered customers.
+func (a *CustomersAdapter) ListWithContext(ctx context.Context, options *ListCustomersOptions) ([]*Customer, *Response, error) {
+	return a.Customers.List(ctx, options)
+}
+
+// Update updates a customer registered by ID or Username.
+func (a *CustomersAdapter) Update(idOrUsername string, customer *Customer) (*Customer, *Response, error) {
+	return a.Customers.Update(context.Background(), idOrUsername, customer)
+}
+
+// UpdateWithContext updates a customer registered by ID or Username.
+func (a *CustomersAdapter) UpdateWithContext(ctx context.Context, idOrUsername string, customer *Customer) (*Customer, *Response, error) {
+	return a.Customers.Update(ctx, idOrUsername, customer)
+}
+
+// Info retrieves the information about the server node.
+func (a *NodeAdapter) Info() (*NodeInfo, *Response, error) {
+	return a.Node.Info(context.Background())
+}
+
+// InfoWithContext retrieves the information about the server node.
+func (a *NodeAdapter) InfoWithContext(ctx context.Context) (*NodeInfo, *Response, error) {
+	return a.Node.Info(ctx)
+}
+
+// Status retrieves the status of the server node.
+func (a *NodeAdapter) Status() (*NodeStatus, *Response, error) {
+	return a.Node.Status(context.Background())
+}
+
+// StatusWithContext retrieves the status of the server node.
+func (a *NodeAdapter) StatusWithContext(ctx context.Context) (*NodeStatus, *Response, error) {
+	return a.Node.Status(ctx)
+}
+
+// WaitReady polls the server node until it is serving and the database is reachable.
+func (a *NodeAdapter) WaitReady(ctx context.Context, options *WaitReadyOptions) error {
+	return a.Node.WaitReady(ctx, options)
+}
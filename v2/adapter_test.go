@@ -0,0 +1,90 @@
+package kongo
+
+import (
+	"context"
+	"fmt"
+	v1 "github.com/fabiorphp/kongo"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type AdapterTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *AdapterTestSuite) TestV1() {
+	services, routes, customers, node := s.client.V1()
+
+	s.assert.Implements(new(v1.Services), services)
+	s.assert.Implements(new(v1.Routes), routes)
+	s.assert.Implements(new(v1.Customers), customers)
+	s.assert.Implements(new(v1.Node), node)
+}
+
+func (s *AdapterTestSuite) TestServicesList() {
+	s.mux.HandleFunc("/services", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("2", r.URL.Query().Get("size"))
+
+		fmt.Fprint(w, `{"data": [{"id": "1"}, {"id": "2"}], "offset": "abc"}`)
+	})
+
+	services, _, _, _ := s.client.V1()
+
+	list, res, err := services.List(&ListServicesOptions{Size: 2})
+
+	s.assert.Nil(err)
+	s.assert.Len(list, 2)
+	s.assert.Equal("abc", res.Offset)
+}
+
+func (s *AdapterTestSuite) TestRoutesDeleteWithContext() {
+	s.mux.HandleFunc("/routes/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodDelete, r.Method)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, routes, _, _ := s.client.V1()
+
+	res, err := routes.DeleteWithContext(context.Background(), "1")
+
+	s.assert.Nil(err)
+	s.assert.Equal(http.StatusNoContent, res.StatusCode)
+}
+
+func (s *AdapterTestSuite) TestCustomersUpdate() {
+	s.mux.HandleFunc("/customers/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPatch, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "username": "bar"}`)
+	})
+
+	_, _, customers, _ := s.client.V1()
+
+	customer, _, err := customers.Update("foo", &Customer{Username: "bar"})
+
+	s.assert.Nil(err)
+	s.assert.Equal("bar", customer.Username)
+}
+
+func (s *AdapterTestSuite) TestNodeStatusUsesDefaults() {
+	client, _ := New(nil, s.server.URL, WithHeader("Kong-Admin-Token", "secret"))
+
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("secret", r.Header.Get("Kong-Admin-Token"))
+
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	_, _, _, node := client.V1()
+
+	status, _, err := node.Status()
+
+	s.assert.Nil(err)
+	s.assert.True(status.Database.Reachable)
+}
+
+func TestAdapterTestSuite(t *testing.T) {
+	suite.Run(t, new(AdapterTestSuite))
+}
@@ -0,0 +1,56 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Customers manages the Kong consumers.
+	Customers interface {
+		// Create creates a new customer.
+		Create(ctx context.Context, customer *Customer, opts ...RequestOption) (*Customer, *Response, error)
+
+		// Delete deletes a customer registered by ID or Username.
+		Delete(ctx context.Context, idOrUsername string, opts ...RequestOption) (*Response, error)
+
+		// Get retrieves a customer registered by ID or Username.
+		Get(ctx context.Context, idOrUsername string, opts ...RequestOption) (*Customer, *Response, error)
+
+		// List retrieves a list of registered customers.
+		List(ctx context.Context, options *ListCustomersOptions, opts ...RequestOption) ([]*Customer, *Response, error)
+
+		// Update updates a customer registered by ID or Username.
+		Update(ctx context.Context, idOrUsername string, customer *Customer, opts ...RequestOption) (*Customer, *Response, error)
+	}
+
+	// CustomersService it's a concrete instance of customers.
+	CustomersService struct {
+		// Kongo client manages communication by API.
+		client *Client
+	}
+)
+
+// Create creates a new customer.
+func (c *CustomersService) Create(ctx context.Context, customer *Customer, opts ...RequestOption) (*Customer, *Response, error) {
+	return c.client.kongo.Customers.CreateWithContext(c.client.context(ctx, opts), customer)
+}
+
+// Delete deletes a customer registered by ID or Username.
+func (c *CustomersService) Delete(ctx context.Context, idOrUsername string, opts ...RequestOption) (*Response, error) {
+	return c.client.kongo.Customers.DeleteWithContext(c.client.context(ctx, opts), idOrUsername)
+}
+
+// Get retrieves a customer registered by ID or Username.
+func (c *CustomersService) Get(ctx context.Context, idOrUsername string, opts ...RequestOption) (*Customer, *Response, error) {
+	return c.client.kongo.Customers.GetWithContext(c.client.context(ctx, opts), idOrUsername)
+}
+
+// List retrieves a list of registered customers.
+func (c *CustomersService) List(ctx context.Context, options *ListCustomersOptions, opts ...RequestOption) ([]*Customer, *Response, error) {
+	return c.client.kongo.Customers.ListWithContext(c.client.context(ctx, opts), options)
+}
+
+// Update updates a customer registered by ID or Username.
+func (c *CustomersService) Update(ctx context.Context, idOrUsername string, customer *Customer, opts ...RequestOption) (*Customer, *Response, error) {
+	return c.client.kongo.Customers.UpdateWithContext(c.client.context(ctx, opts), idOrUsername, customer)
+}
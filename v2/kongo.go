@@ -0,0 +1,121 @@
+// Package kongo is the context-first Kongo API. Every method takes the context first and accepts request
+// options, e.g. extra headers or a timeout, applied to that call only. It is built on the v1 client, sharing
+// its entities, interceptors and error handling.
+package kongo
+
+import (
+	"context"
+	v1 "github.com/fabiorphp/kongo"
+	"net/http"
+)
+
+type (
+	// Service it's a Kong upstream service.
+	Service = v1.Service
+
+	// ListServicesOptions stores the options you can set for requesting the service list.
+	ListServicesOptions = v1.ListServicesOptions
+
+	// Route it's a Kong route.
+	Route = v1.Route
+
+	// ListRoutesOptions stores the options you can set for requesting the route list.
+	ListRoutesOptions = v1.ListRoutesOptions
+
+	// Customer it's a Kong consumer.
+	Customer = v1.Customer
+
+	// ListCustomersOptions stores the options you can set for requesting the customer list.
+	ListCustomersOptions = v1.ListCustomersOptions
+
+	// NodeInfo it's the information about the server node.
+	NodeInfo = v1.NodeInfo
+
+	// NodeStatus it's the status of the server node.
+	NodeStatus = v1.NodeStatus
+
+	// WaitReadyOptions stores the options you can set for waiting the node readiness.
+	WaitReadyOptions = v1.WaitReadyOptions
+
+	// Schema it's a Kong entity or plugin schema.
+	Schema = v1.Schema
+
+	// Response it's an API response.
+	Response = v1.Response
+
+	// Client manages communication with Kong Admin API.
+	Client struct {
+		// Node api service.
+		Node Node
+
+		// Services api service.
+		Services Services
+
+		// Routes api service.
+		Routes Routes
+
+		// Customers api service.
+		Customers Customers
+
+		// Schemas api service.
+		Schemas Schemas
+
+		// The v1 client sending the requests.
+		kongo *v1.Kongo
+
+		// Options applied to every call, before the call options.
+		defaults []RequestOption
+	}
+)
+
+// New returns a new Kongo API client.
+func New(client *http.Client, baseURL string, defaults ...RequestOption) (*Client, error) {
+	k, err := v1.New(client, baseURL)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(k, defaults...), nil
+}
+
+// NewClient returns a new Kongo API client sending the requests through the v1 client. The request options
+// are applied by interceptors carried by the call context, before the interceptors registered on the v1
+// client, which is left untouched.
+func NewClient(k *v1.Kongo, defaults ...RequestOption) *Client {
+	c := &Client{kongo: k, defaults: defaults}
+	c.Node = &NodeService{c}
+	c.Services = &ServicesService{c}
+	c.Routes = &RoutesService{c}
+	c.Customers = &CustomersService{c}
+	c.Schemas = &SchemasService{c}
+
+	return c
+}
+
+// Kongo returns the v1 client sending the requests.
+func (c *Client) Kongo() *v1.Kongo {
+	return c.kongo
+}
+
+// context returns a copy of the context carrying the default and the call options.
+func (c *Client) context(ctx context.Context, opts []RequestOption) context.Context {
+	options := &requestOptions{basePath: c.kongo.BasePath()}
+
+	for _, opt := range c.defaults {
+		opt(options)
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.retries > 0 {
+		return v1.ContextWithInterceptors(ctx, options.intercept, v1.RetryInterceptor(&v1.RetryOptions{
+			Retries: options.retries,
+			Backoff: retryBackoff,
+		}))
+	}
+
+	return v1.ContextWithInterceptors(ctx, options.intercept)
+}
@@ -0,0 +1,59 @@
+package kongo
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type (
+	BaseTestSuite struct {
+		suite.Suite
+
+		assert *assert.Assertions
+		client *Client
+
+		mux    *http.ServeMux
+		server *httptest.Server
+	}
+
+	ClientTestSuite struct {
+		BaseTestSuite
+	}
+)
+
+func (s *BaseTestSuite) SetupTest() {
+	s.mux = http.NewServeMux()
+	s.server = httptest.NewServer(s.mux)
+
+	client, _ := New(nil, s.server.URL)
+	s.client = client
+
+	s.assert = assert.New(s.T())
+}
+
+func (s *BaseTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ClientTestSuite) TestNewWithEmptyURL() {
+	client, err := New(nil, "")
+
+	s.assert.Nil(client)
+	s.assert.Error(err)
+}
+
+func (s *ClientTestSuite) TestInstance() {
+	s.assert.Implements(new(Node), s.client.Node)
+	s.assert.Implements(new(Services), s.client.Services)
+	s.assert.Implements(new(Routes), s.client.Routes)
+	s.assert.Implements(new(Customers), s.client.Customers)
+	s.assert.Implements(new(Schemas), s.client.Schemas)
+	s.assert.Equal(s.server.URL, s.client.Kongo().BaseURL.String())
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
@@ -0,0 +1,46 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Node retrieves the info about the server nodes.
+	Node interface {
+		// Info retrieves the information about the server node.
+		Info(ctx context.Context, opts ...RequestOption) (*NodeInfo, *Response, error)
+
+		// Status retrieves the status of the server node.
+		Status(ctx context.Context, opts ...RequestOption) (*NodeStatus, *Response, error)
+
+		// WaitReady polls the server node until it is serving and the database is reachable.
+		WaitReady(ctx context.Context, options *WaitReadyOptions, opts ...RequestOption) error
+	}
+
+	// NodeService it's a concrete instance of node.
+	NodeService struct {
+		// Kongo client manages communication by API.
+		client *Client
+	}
+)
+
+// Info retrieves the information about the server node.
+func (n *NodeService) Info(ctx context.Context, opts ...RequestOption) (*NodeInfo, *Response, error) {
+	return n.client.kongo.Node.InfoWithContext(n.context(ctx, opts))
+}
+
+// Status retrieves the status of the server node.
+func (n *NodeService) Status(ctx context.Context, opts ...RequestOption) (*NodeStatus, *Response, error) {
+	return n.client.kongo.Node.StatusWithContext(n.context(ctx, opts))
+}
+
+// WaitReady polls the server node until it is serving and the database is reachable. The request options
+// apply to every poll, a timeout limits each of them.
+func (n *NodeService) WaitReady(ctx context.Context, options *WaitReadyOptions, opts ...RequestOption) error {
+	return n.client.kongo.Node.WaitReady(n.context(ctx, opts), options)
+}
+
+// context returns the call context without the workspace, the node endpoints aren't scoped to one.
+func (n *NodeService) context(ctx context.Context, opts []RequestOption) context.Context {
+	return n.client.context(ctx, append(opts[:len(opts):len(opts)], WithWorkspace("")))
+}
@@ -0,0 +1,105 @@
+package kongo
+
+import (
+	"context"
+	v1 "github.com/fabiorphp/kongo"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type (
+	// RequestOption sets an option of the API calls.
+	RequestOption func(options *requestOptions)
+
+	// requestOptions stores the options of a call.
+	requestOptions struct {
+		// Headers added to the request.
+		headers http.Header
+
+		// Timeout of the call, retries included, zero for none.
+		timeout time.Duration
+
+		// Number of times the request is resent on network errors and 5xx responses.
+		retries int
+
+		// Kong Enterprise workspace the call is scoped to.
+		workspace string
+
+		// Prefix of the resource paths of the v1 client, the workspace goes right after it.
+		basePath string
+	}
+)
+
+// retryBackoff returns how long to wait before the retry attempt, nil for the v1 exponential backoff.
+var retryBackoff func(attempt int) time.Duration
+
+// WithHeader adds a header to the requests, e.g. Kong-Admin-Token.
+func WithHeader(key, value string) RequestOption {
+	return func(options *requestOptions) {
+		if options.headers == nil {
+			options.headers = http.Header{}
+		}
+
+		options.headers.Add(key, value)
+	}
+}
+
+// WithTimeout limits the call duration, retries included.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(options *requestOptions) {
+		options.timeout = timeout
+	}
+}
+
+// WithRetries sets how many times the request is resent on network errors and 5xx responses, waiting
+// between attempts an exponential backoff from 100ms up to 2s. Retries are flagged like the ones of
+// v1.RetryInterceptor. Requests are not idempotent on every endpoint, e.g. creates, so use it carefully
+// on writes.
+func WithRetries(retries int) RequestOption {
+	return func(options *requestOptions) {
+		options.retries = retries
+	}
+}
+
+// WithWorkspace scopes the call to a Kong Enterprise workspace. The node calls ignore it.
+func WithWorkspace(workspace string) RequestOption {
+	return func(options *requestOptions) {
+		options.workspace = workspace
+	}
+}
+
+// intercept applies the call options to the request, the retries being left to v1.RetryInterceptor.
+func (options *requestOptions) intercept(req *http.Request, value interface{}, next v1.Handler) (*http.Response, error) {
+	ctx := req.Context()
+
+	if options.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+
+		defer cancel()
+	}
+
+	req = req.Clone(ctx)
+
+	if options.workspace != "" {
+		prefix, resource := "", req.URL.Path
+
+		if strings.HasPrefix(resource, options.basePath+"/") {
+			prefix, resource = options.basePath, strings.TrimPrefix(resource, options.basePath)
+		}
+
+		req.URL.Path = prefix + "/" + url.PathEscape(options.workspace) + resource
+		req.URL.RawPath = ""
+	}
+
+	for key, values := range options.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return next(req, value)
+}
@@ -0,0 +1,284 @@
+package kongo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	v1 "github.com/fabiorphp/kongo"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type OptionsTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *OptionsTestSuite) SetupTest() {
+	s.BaseTestSuite.SetupTest()
+
+	retryBackoff = func(attempt int) time.Duration { return 0 }
+}
+
+func (s *OptionsTestSuite) TearDownTest() {
+	s.BaseTestSuite.TearDownTest()
+
+	retryBackoff = nil
+}
+
+func (s *OptionsTestSuite) TestWithHeader() {
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("secret", r.Header.Get("Kong-Admin-Token"))
+		s.assert.Equal([]string{"a", "b"}, r.Header.Values("X-Tag"))
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	_, _, err := s.client.Services.Get(context.Background(), "foo", WithHeader("Kong-Admin-Token", "secret"), WithHeader("X-Tag", "a"), WithHeader("X-Tag", "b"))
+
+	s.assert.Nil(err)
+}
+
+func (s *OptionsTestSuite) TestDefaultsAreOverriddenByCall() {
+	client, _ := New(nil, s.server.URL, WithWorkspace("default"), WithHeader("Kong-Admin-Token", "secret"))
+
+	s.mux.HandleFunc("/team-a/routes/1", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal("secret", r.Header.Get("Kong-Admin-Token"))
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	route, _, err := client.Routes.Get(context.Background(), "1", WithWorkspace("team-a"))
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", route.Id)
+}
+
+func (s *OptionsTestSuite) TestWithWorkspace() {
+	s.mux.HandleFunc("/team-a/customers", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "username": "foo"}`)
+	})
+
+	customer, _, err := s.client.Customers.Create(context.Background(), &Customer{Username: "foo"}, WithWorkspace("team-a"))
+
+	s.assert.Nil(err)
+	s.assert.Equal("foo", customer.Username)
+}
+
+func (s *OptionsTestSuite) TestSchemasWithWorkspace() {
+	s.mux.HandleFunc("/team-a/schemas/routes/validate", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPost, r.Method)
+		s.assert.Equal("secret", r.Header.Get("Kong-Admin-Token"))
+
+		fmt.Fprint(w, `{"message": "schema validation successful"}`)
+	})
+
+	_, err := s.client.Schemas.ValidateRoute(context.Background(), &Route{Paths: []string{"/foo"}}, WithWorkspace("team-a"), WithHeader("Kong-Admin-Token", "secret"))
+
+	s.assert.Nil(err)
+}
+
+func (s *OptionsTestSuite) TestWithWorkspaceAndBasePath() {
+	k, _ := v1.NewWithOptions(s.server.URL + "/admin-api")
+	client := NewClient(k)
+
+	s.mux.HandleFunc("/admin-api/team-a/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	svc, _, err := client.Services.Get(context.Background(), "foo", WithWorkspace("team-a"))
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+}
+
+func (s *OptionsTestSuite) TestWorkspaceIsNotSentToNodeEndpoints() {
+	client := NewClient(s.client.Kongo(), WithWorkspace("team-a"))
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			s.Failf("unexpected request", "%s %s", r.Method, r.URL.Path)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"version": "3.4.0"}`)
+	})
+
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	s.mux.HandleFunc("/status/ready", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+
+	s.mux.HandleFunc("/team-a/routes", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "tags": ["a"]}`)
+	})
+
+	s.assert.Nil(client.Node.WaitReady(context.Background(), nil))
+
+	route, _, err := client.Routes.Create(context.Background(), &Route{Tags: []string{"a"}})
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", route.Id)
+}
+
+func (s *OptionsTestSuite) TestClientIsLeftUntouched() {
+	k, _ := v1.New(nil, s.server.URL)
+
+	NewClient(k, WithWorkspace("team-a"), WithHeader("X-A", "1"))
+	client := NewClient(k, WithWorkspace("team-a"), WithHeader("X-A", "1"))
+
+	s.mux.HandleFunc("/team-a/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal([]string{"1"}, r.Header.Values("X-A"))
+
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Empty(r.Header.Values("X-A"))
+
+		fmt.Fprint(w, `{"id": "2"}`)
+	})
+
+	svc, _, err := client.Services.Get(context.Background(), "foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", svc.Id)
+
+	svc, _, err = k.Services.Get("foo")
+
+	s.assert.Nil(err)
+	s.assert.Equal("2", svc.Id)
+}
+
+func (s *OptionsTestSuite) TestRetriesStopWhenContextIsDone() {
+	retryBackoff = func(attempt int) time.Duration { return time.Minute }
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, _, err := s.client.Services.Get(context.Background(), "foo", WithRetries(3), WithTimeout(20*time.Millisecond))
+
+	s.assert.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *OptionsTestSuite) TestWithTimeout() {
+	s.mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	_, res, err := s.client.Node.Status(context.Background(), WithTimeout(10*time.Millisecond))
+
+	s.assert.Nil(res)
+	s.assert.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func (s *OptionsTestSuite) TestWithRetries() {
+	attempts := 0
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPatch, r.Method)
+
+		body := map[string]interface{}{}
+
+		json.NewDecoder(r.Body).Decode(&body)
+
+		s.assert.Equal("foo.org", body["host"])
+
+		attempts++
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "1", "host": "foo.org"}`)
+	})
+
+	svc, _, err := s.client.Services.Update(context.Background(), "foo", &Service{Host: "foo.org"}, WithRetries(2))
+
+	s.assert.Nil(err)
+	s.assert.Equal(3, attempts)
+	s.assert.Equal("foo.org", svc.Host)
+}
+
+func (s *OptionsTestSuite) TestWithRetriesGivesUp() {
+	attempts := 0
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, res, err := s.client.Services.Get(context.Background(), "foo", WithRetries(1))
+
+	s.assert.Error(err)
+	s.assert.Equal(http.StatusInternalServerError, res.StatusCode)
+	s.assert.Equal(2, attempts)
+}
+
+func (s *OptionsTestSuite) TestRetriesAreFlagged() {
+	k, _ := v1.New(nil, s.server.URL)
+	client := NewClient(k)
+	attempts := []int{}
+
+	k.Use(func(req *http.Request, value interface{}, next v1.Handler) (*http.Response, error) {
+		attempts = append(attempts, v1.RetryAttempt(req.Context()))
+
+		return next(req, value)
+	})
+
+	s.mux.HandleFunc("/services/foo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, _, err := client.Services.Get(context.Background(), "foo", WithRetries(2))
+
+	s.assert.Error(err)
+	s.assert.Equal([]int{0, 1, 2}, attempts)
+}
+
+func (s *OptionsTestSuite) TestDecodeErrorsAreNotRetried() {
+	attempts := 0
+
+	s.mux.HandleFunc("/services", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `{"id": `)
+	})
+
+	_, _, err := s.client.Services.Create(context.Background(), &Service{Name: "foo", Host: "foo.org"}, WithRetries(3))
+
+	s.assert.Error(err)
+	s.assert.Equal(1, attempts)
+}
+
+func (s *OptionsTestSuite) TestClientErrorsAreNotRetried() {
+	attempts := 0
+
+	s.mux.HandleFunc("/routes/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := s.client.Routes.Get(context.Background(), "1", WithRetries(3))
+
+	s.assert.Error(err)
+	s.assert.Equal(1, attempts)
+}
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
@@ -0,0 +1,64 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Routes manages the Kong routes.
+	Routes interface {
+		// Create creates a new route.
+		Create(ctx context.Context, route *Route, opts ...RequestOption) (*Route, *Response, error)
+
+		// Delete deletes a route registered by ID.
+		Delete(ctx context.Context, id string, opts ...RequestOption) (*Response, error)
+
+		// Get retrieves a route registered by ID.
+		Get(ctx context.Context, id string, opts ...RequestOption) (*Route, *Response, error)
+
+		// List retrieves a list of registered routes.
+		List(ctx context.Context, options *ListRoutesOptions, opts ...RequestOption) ([]*Route, *Response, error)
+
+		// Update updates a route registered by ID.
+		Update(ctx context.Context, id string, route *Route, opts ...RequestOption) (*Route, *Response, error)
+
+		// UpdateIfUnchanged updates a route registered by ID when it was not changed since the seen version.
+		UpdateIfUnchanged(ctx context.Context, id string, seen *Route, route *Route, opts ...RequestOption) (*Route, *Response, error)
+	}
+
+	// RoutesService it's a concrete instance of routes.
+	RoutesService struct {
+		// Kongo client manages communication by API.
+		client *Client
+	}
+)
+
+// Create creates a new route.
+func (r *RoutesService) Create(ctx context.Context, route *Route, opts ...RequestOption) (*Route, *Response, error) {
+	return r.client.kongo.Routes.CreateWithContext(r.client.context(ctx, opts), route)
+}
+
+// Delete deletes a route registered by ID.
+func (r *RoutesService) Delete(ctx context.Context, id string, opts ...RequestOption) (*Response, error) {
+	return r.client.kongo.Routes.DeleteWithContext(r.client.context(ctx, opts), id)
+}
+
+// Get retrieves a route registered by ID.
+func (r *RoutesService) Get(ctx context.Context, id string, opts ...RequestOption) (*Route, *Response, error) {
+	return r.client.kongo.Routes.GetWithContext(r.client.context(ctx, opts), id)
+}
+
+// List retrieves a list of registered routes.
+func (r *RoutesService) List(ctx context.Context, options *ListRoutesOptions, opts ...RequestOption) ([]*Route, *Response, error) {
+	return r.client.kongo.Routes.ListWithContext(r.client.context(ctx, opts), options)
+}
+
+// Update updates a route registered by ID.
+func (r *RoutesService) Update(ctx context.Context, id string, route *Route, opts ...RequestOption) (*Route, *Response, error) {
+	return r.client.kongo.Routes.UpdateWithContext(r.client.context(ctx, opts), id, route)
+}
+
+// UpdateIfUnchanged updates a route registered by ID when it was not changed since the seen version.
+func (r *RoutesService) UpdateIfUnchanged(ctx context.Context, id string, seen *Route, route *Route, opts ...RequestOption) (*Route, *Response, error) {
+	return r.client.kongo.Routes.UpdateIfUnchangedWithContext(r.client.context(ctx, opts), id, seen, route)
+}
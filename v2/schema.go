@@ -0,0 +1,64 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Schemas retrieves the Kong entity and plugin schemas and validates payloads against them.
+	Schemas interface {
+		// Entity retrieves the schema of an entity, e.g. services.
+		Entity(ctx context.Context, name string, opts ...RequestOption) (*Schema, *Response, error)
+
+		// Plugin retrieves the schema of a plugin, e.g. rate-limiting.
+		Plugin(ctx context.Context, name string, opts ...RequestOption) (*Schema, *Response, error)
+
+		// Validate validates an entity payload without persisting it.
+		Validate(ctx context.Context, name string, payload interface{}, opts ...RequestOption) (*Response, error)
+
+		// ValidateService validates a service as sent on create without persisting it.
+		ValidateService(ctx context.Context, svc *Service, opts ...RequestOption) (*Response, error)
+
+		// ValidateRoute validates a route as sent on create without persisting it.
+		ValidateRoute(ctx context.Context, route *Route, opts ...RequestOption) (*Response, error)
+
+		// ValidatePlugin validates a plugin configuration, e.g. {"name": "cors", "config": {...}}, without persisting it.
+		ValidatePlugin(ctx context.Context, plugin interface{}, opts ...RequestOption) (*Response, error)
+	}
+
+	// SchemasService it's a concrete instance of schemas.
+	SchemasService struct {
+		// Kongo client manages communication by API.
+		client *Client
+	}
+)
+
+// Entity retrieves the schema of an entity, e.g. services.
+func (s *SchemasService) Entity(ctx context.Context, name string, opts ...RequestOption) (*Schema, *Response, error) {
+	return s.client.kongo.Schemas.EntityWithContext(s.client.context(ctx, opts), name)
+}
+
+// Plugin retrieves the schema of a plugin, e.g. rate-limiting.
+func (s *SchemasService) Plugin(ctx context.Context, name string, opts ...RequestOption) (*Schema, *Response, error) {
+	return s.client.kongo.Schemas.PluginWithContext(s.client.context(ctx, opts), name)
+}
+
+// Validate validates an entity payload without persisting it.
+func (s *SchemasService) Validate(ctx context.Context, name string, payload interface{}, opts ...RequestOption) (*Response, error) {
+	return s.client.kongo.Schemas.ValidateWithContext(s.client.context(ctx, opts), name, payload)
+}
+
+// ValidateService validates a service as sent on create without persisting it.
+func (s *SchemasService) ValidateService(ctx context.Context, svc *Service, opts ...RequestOption) (*Response, error) {
+	return s.client.kongo.Schemas.ValidateServiceWithContext(s.client.context(ctx, opts), svc)
+}
+
+// ValidateRoute validates a route as sent on create without persisting it.
+func (s *SchemasService) ValidateRoute(ctx context.Context, route *Route, opts ...RequestOption) (*Response, error) {
+	return s.client.kongo.Schemas.ValidateRouteWithContext(s.client.context(ctx, opts), route)
+}
+
+// ValidatePlugin validates a plugin configuration without persisting it.
+func (s *SchemasService) ValidatePlugin(ctx context.Context, plugin interface{}, opts ...RequestOption) (*Response, error) {
+	return s.client.kongo.Schemas.ValidatePluginWithContext(s.client.context(ctx, opts), plugin)
+}
@@ -0,0 +1,80 @@
+package kongo
+
+import (
+	"context"
+)
+
+type (
+	// Services manages the Kong upstream services.
+	Services interface {
+		// Create creates a new service.
+		Create(ctx context.Context, svc *Service, opts ...RequestOption) (*Service, *Response, error)
+
+		// CreateByURL creates a new service by URL.
+		CreateByURL(ctx context.Context, svc *Service, opts ...RequestOption) (*Service, *Response, error)
+
+		// Delete deletes a service registered by ID or Name.
+		Delete(ctx context.Context, idOrName string, opts ...RequestOption) (*Response, error)
+
+		// Get retrieves a service registered by ID or Name.
+		Get(ctx context.Context, idOrName string, opts ...RequestOption) (*Service, *Response, error)
+
+		// List retrieves a list of registered services.
+		List(ctx context.Context, options *ListServicesOptions, opts ...RequestOption) ([]*Service, *Response, error)
+
+		// Update updates a service registered by ID or Name.
+		Update(ctx context.Context, idOrName string, svc *Service, opts ...RequestOption) (*Service, *Response, error)
+
+		// UpdateByURL updates a service registered by ID or Name through its URL.
+		UpdateByURL(ctx context.Context, idOrName string, svc *Service, opts ...RequestOption) (*Service, *Response, error)
+
+		// UpdateIfUnchanged updates a service registered by ID or Name when it was not changed since the seen version.
+		UpdateIfUnchanged(ctx context.Context, idOrName string, seen *Service, svc *Service, opts ...RequestOption) (*Service, *Response, error)
+	}
+
+	// ServicesService it's a concrete instance of services.
+	ServicesService struct {
+		// Kongo client manages communication by API.
+		client *Client
+	}
+)
+
+// Create creates a new service.
+func (s *ServicesService) Create(ctx context.Context, svc *Service, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.CreateWithContext(s.client.context(ctx, opts), svc)
+}
+
+// CreateByURL creates a new service by URL.
+func (s *ServicesService) CreateByURL(ctx context.Context, svc *Service, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.CreateByURLWithContext(s.client.context(ctx, opts), svc)
+}
+
+// Delete deletes a service registered by ID or Name.
+func (s *ServicesService) Delete(ctx context.Context, idOrName string, opts ...RequestOption) (*Response, error) {
+	return s.client.kongo.Services.DeleteWithContext(s.client.context(ctx, opts), idOrName)
+}
+
+// Get retrieves a service registered by ID or Name.
+func (s *ServicesService) Get(ctx context.Context, idOrName string, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.GetWithContext(s.client.context(ctx, opts), idOrName)
+}
+
+// List retrieves a list of registered services.
+func (s *ServicesService) List(ctx context.Context, options *ListServicesOptions, opts ...RequestOption) ([]*Service, *Response, error) {
+	return s.client.kongo.Services.ListWithContext(s.client.context(ctx, opts), options)
+}
+
+// Update updates a service registered by ID or Name.
+func (s *ServicesService) Update(ctx context.Context, idOrName string, svc *Service, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.UpdateWithContext(s.client.context(ctx, opts), idOrName, svc)
+}
+
+// UpdateByURL updates a service registered by ID or Name through its URL.
+func (s *ServicesService) UpdateByURL(ctx context.Context, idOrName string, svc *Service, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.UpdateByURLWithContext(s.client.context(ctx, opts), idOrName, svc)
+}
+
+// UpdateIfUnchanged updates a service registered by ID or Name when it was not changed since the seen version.
+func (s *ServicesService) UpdateIfUnchanged(ctx context.Context, idOrName string, seen *Service, svc *Service, opts ...RequestOption) (*Service, *Response, error) {
+	return s.client.kongo.Services.UpdateIfUnchangedWithContext(s.client.context(ctx, opts), idOrName, seen, svc)
+}
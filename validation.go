@@ -0,0 +1,211 @@
+package kongo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// protocols stores the protocols accepted by Kong services and routes.
+var protocols = map[string]bool{
+	"grpc":            true,
+	"grpcs":           true,
+	"http":            true,
+	"https":           true,
+	"tcp":             true,
+	"tls":             true,
+	"tls_passthrough": true,
+	"udp":             true,
+	"ws":              true,
+	"wss":             true,
+}
+
+// serviceMethods stores the service method sending each field group.
+var serviceMethods = map[string]string{
+	"create":     "Create",
+	"create_url": "CreateByURL",
+	"update":     "Update",
+	"update_url": "UpdateByURL",
+}
+
+type (
+	// FieldError it's an invalid entity attribute.
+	FieldError struct {
+		// The JSON name of the attribute, e.g. paths[0].
+		Field string
+
+		// The reason why the attribute is invalid.
+		Message string
+	}
+
+	// ValidationError it's returned when an entity is rejected before reaching the Admin API.
+	ValidationError struct {
+		// The entity type, e.g. route.
+		Entity string
+
+		// The invalid attributes.
+		Fields []*FieldError
+	}
+
+	// validator it's an entity validated before being sent, partially on updates.
+	validator interface {
+		validate(partial bool) error
+	}
+)
+
+// Error returns the field and the reason.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// Error returns all invalid fields of the entity.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+
+	for _, field := range e.Fields {
+		messages = append(messages, field.Error())
+	}
+
+	return fmt.Sprintf("Invalid %s: %s", e.Entity, strings.Join(messages, "; "))
+}
+
+// add records an invalid field.
+func (e *ValidationError) add(field, format string, args ...interface{}) {
+	e.Fields = append(e.Fields, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns the validation error when any field is invalid.
+func (e *ValidationError) err() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Validate checks the service attributes rejected by Kong.
+func (s *Service) Validate() error {
+	return s.validate(false)
+}
+
+// validate checks the service attributes, partial skips the required ones.
+func (s *Service) validate(partial bool) error {
+	return s.validateGroup("", partial)
+}
+
+// validateGroup checks the service attributes sent by the field group, partial skips the required ones. The
+// url is only sent by the *ByURL methods while host, path, port and protocol are only sent by the others.
+func (s *Service) validateGroup(groupName string, partial bool) error {
+	v := &ValidationError{Entity: "service"}
+	method := serviceMethods[groupName]
+
+	switch groupName {
+	case "create", "update":
+		if s.URL != "" && s.Host == "" {
+			v.add("url", "is not sent by %s, use %sByURL", method, method)
+		}
+	case "create_url", "update_url":
+		if !partial && s.URL == "" {
+			v.add("url", "is required")
+		}
+
+		if s.URL == "" && s.Host != "" {
+			v.add("host", "is not sent by %s, set it in url", method)
+		}
+
+		if s.Path != "" {
+			v.add("path", "is not sent by %s, set it in url", method)
+		}
+
+		if s.Port != 0 {
+			v.add("port", "is not sent by %s, set it in url", method)
+		}
+
+		if s.Protocol != "" {
+			v.add("protocol", "is not sent by %s, set it in url", method)
+		}
+	}
+
+	if s.URL != "" && s.Host != "" {
+		v.add("url", "must not be set together with host")
+	}
+
+	if !partial && s.URL == "" && s.Host == "" && !strings.HasSuffix(groupName, "_url") {
+		v.add("host", "is required when url is not set")
+	}
+
+	if s.Protocol != "" && !protocols[s.Protocol] {
+		v.add("protocol", "has unknown protocol %q", s.Protocol)
+	}
+
+	if s.Port < 0 || s.Port > 65535 {
+		v.add("port", "must be between 0 and 65535")
+	}
+
+	if s.Path != "" && !strings.HasPrefix(s.Path, "/") {
+		v.add("path", "must start with /")
+	}
+
+	return v.err()
+}
+
+// Validate checks the route attributes rejected by Kong.
+func (r *Route) Validate() error {
+	return r.validate(false)
+}
+
+// validate checks the route attributes, partial skips the required ones.
+func (r *Route) validate(partial bool) error {
+	v := &ValidationError{Entity: "route"}
+
+	if !partial && len(r.Hosts) == 0 && len(r.Paths) == 0 && len(r.Methods) == 0 {
+		v.add("hosts", "must be set when paths and methods are empty")
+	}
+
+	for i, protocol := range r.Protocols {
+		if !protocols[protocol] {
+			v.add(fmt.Sprintf("protocols[%d]", i), "has unknown protocol %q", protocol)
+		}
+	}
+
+	for i, path := range r.Paths {
+		if !strings.HasPrefix(strings.TrimPrefix(path, "~"), "/") {
+			v.add(fmt.Sprintf("paths[%d]", i), "must start with / or ~/ for regex paths")
+		}
+	}
+
+	return v.err()
+}
+
+// Validate checks the customer attributes rejected by Kong.
+func (c *Customer) Validate() error {
+	return c.validate(false)
+}
+
+// validate checks the customer attributes, partial skips the required ones.
+func (c *Customer) validate(partial bool) error {
+	v := &ValidationError{Entity: "customer"}
+
+	if !partial && c.Username == "" && c.CustomId == "" {
+		v.add("username", "must be set when custom_id is empty")
+	}
+
+	return v.err()
+}
+
+// validate runs the entity validation when enabled on the client.
+func (k *Kongo) validate(entity validator, partial bool) error {
+	if !k.ValidateEntities {
+		return nil
+	}
+
+	return entity.validate(partial)
+}
+
+// validateService runs the validation of the service field group when enabled on the client.
+func (k *Kongo) validateService(svc *Service, groupName string, partial bool) error {
+	if !k.ValidateEntities {
+		return nil
+	}
+
+	return svc.validateGroup(groupName, partial)
+}
@@ -0,0 +1,123 @@
+package kongo
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"testing"
+)
+
+type ValidationTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *ValidationTestSuite) TestServiceValidate() {
+	err := (&Service{URL: "http://foo.org", Host: "foo.org", Protocol: "ftp", Port: 70000, Path: "api"}).Validate()
+
+	s.assert.IsType(&ValidationError{}, err)
+	s.assert.Equal([]*FieldError{
+		{Field: "url", Message: "must not be set together with host"},
+		{Field: "protocol", Message: `has unknown protocol "ftp"`},
+		{Field: "port", Message: "must be between 0 and 65535"},
+		{Field: "path", Message: "must start with /"},
+	}, err.(*ValidationError).Fields)
+
+	s.assert.Nil((&Service{Host: "foo.org", Protocol: "https", Port: 443, Path: "/api"}).Validate())
+	s.assert.Nil((&Service{URL: "http://foo.org"}).Validate())
+}
+
+func (s *ValidationTestSuite) TestServiceValidateRequiresHost() {
+	err := (&Service{Name: "foo"}).Validate()
+
+	s.assert.EqualError(err, "Invalid service: host is required when url is not set")
+}
+
+func (s *ValidationTestSuite) TestRouteValidate() {
+	err := (&Route{Protocols: []string{"http", "smtp"}}).Validate()
+
+	s.assert.EqualError(err, `Invalid route: hosts must be set when paths and methods are empty; protocols[1] has unknown protocol "smtp"`)
+
+	err = (&Route{Paths: []string{"/api", "v2"}}).Validate()
+
+	s.assert.EqualError(err, "Invalid route: paths[1] must start with / or ~/ for regex paths")
+
+	s.assert.Nil((&Route{Methods: []string{"GET"}, Protocols: []string{"https"}}).Validate())
+	s.assert.Nil((&Route{Paths: []string{`~/api/v\d+$`}}).Validate())
+	s.assert.Error((&Route{Paths: []string{`~api`}}).Validate())
+}
+
+func (s *ValidationTestSuite) TestCustomerValidate() {
+	s.assert.EqualError((&Customer{}).Validate(), "Invalid customer: username must be set when custom_id is empty")
+	s.assert.Nil((&Customer{CustomId: "1"}).Validate())
+}
+
+func (s *ValidationTestSuite) TestCreateValidatesBeforeRequest() {
+	s.client.ValidateEntities = true
+
+	s.mux.HandleFunc(routesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("Request should not be sent")
+	})
+
+	route, res, err := s.client.Routes.Create(&Route{Paths: []string{"api"}})
+
+	s.assert.Nil(route)
+	s.assert.Nil(res)
+	s.assert.IsType(&ValidationError{}, err)
+}
+
+func (s *ValidationTestSuite) TestUpdateValidatesPartially() {
+	s.client.ValidateEntities = true
+
+	s.mux.HandleFunc(servicesResourcePath+"/foo", func(w http.ResponseWriter, r *http.Request) {
+		s.assert.Equal(http.MethodPatch, r.Method)
+
+		fmt.Fprint(w, `{"id": "1", "retries": 3}`)
+	})
+
+	svc, _, err := s.client.Services.Update("foo", &Service{Retries: 3})
+
+	s.assert.Nil(err)
+	s.assert.Equal(3, svc.Retries)
+
+	_, _, err = s.client.Services.Update("foo", &Service{Port: -1})
+
+	s.assert.EqualError(err, "Invalid service: port must be between 0 and 65535")
+}
+
+func (s *ValidationTestSuite) TestServiceValidatesSentFields() {
+	s.client.ValidateEntities = true
+
+	s.mux.HandleFunc(servicesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	_, _, err := s.client.Services.Create(&Service{URL: "http://foo.org"})
+
+	s.assert.EqualError(err, "Invalid service: url is not sent by Create, use CreateByURL")
+
+	_, _, err = s.client.Services.CreateByURL(&Service{Host: "foo.org", Port: 8080})
+
+	s.assert.EqualError(err, "Invalid service: url is required; host is not sent by CreateByURL, set it in url; port is not sent by CreateByURL, set it in url")
+
+	_, _, err = s.client.Services.CreateByURL(&Service{URL: "http://foo.org"})
+
+	s.assert.Nil(err)
+
+	_, _, err = s.client.Services.Update("foo", &Service{URL: "http://foo.org"})
+
+	s.assert.EqualError(err, "Invalid service: url is not sent by Update, use UpdateByURL")
+}
+
+func (s *ValidationTestSuite) TestValidationIsDisabledByDefault() {
+	s.mux.HandleFunc(customersResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1"}`)
+	})
+
+	_, _, err := s.client.Customers.Create(&Customer{})
+
+	s.assert.Nil(err)
+}
+
+func TestValidationTestSuite(t *testing.T) {
+	suite.Run(t, new(ValidationTestSuite))
+}
@@ -0,0 +1,272 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CapabilityTags it's the tags attribute of the entities.
+	CapabilityTags Capability = "tags"
+
+	// CapabilityPathHandling it's the path_handling attribute of the routes.
+	CapabilityPathHandling Capability = "path_handling"
+
+	// CapabilityReadiness it's the /status/ready endpoint.
+	CapabilityReadiness Capability = "readiness"
+)
+
+// ErrUnsupportedByServer is returned when the call needs a newer Kong version.
+var ErrUnsupportedByServer = errors.New("Unsupported by server")
+
+// capabilities stores the minimum Kong version of each capability.
+var capabilities = map[Capability]*Version{
+	CapabilityTags:         {Major: 1, Minor: 1},
+	CapabilityPathHandling: {Major: 2},
+	CapabilityReadiness:    {Major: 3, Minor: 3},
+}
+
+// prereleasePattern splits a pre-release into its label and number, e.g. rc and 10.
+var prereleasePattern = regexp.MustCompile(`^(\D*)(\d*)(.*)$`)
+
+// versionPattern matches Kong versions, e.g. 0.13.0, 1.0.0rc3 or 2.8.1.0-enterprise-edition.
+var versionPattern = regexp.MustCompile(`^(\d+)\.(\d+)(?:\.(\d+))?(?:\.(\d+))?(.*)$`)
+
+type (
+	// Capability it's a Kong feature that depends on the server version.
+	Capability string
+
+	// Version it's a Kong server version.
+	Version struct {
+		// The major version.
+		Major int
+
+		// The minor version.
+		Minor int
+
+		// The patch version.
+		Patch int
+
+		// The build version, used by Kong Enterprise.
+		Build int
+
+		// The pre-release, e.g. rc1, empty for final releases.
+		Prerelease string
+
+		// Whether it's a Kong Enterprise version.
+		Enterprise bool
+
+		// The raw version.
+		Raw string
+	}
+)
+
+// ParseVersion parses a Kong version, handling the Enterprise versions like 2.8.1.0-enterprise-edition.
+func ParseVersion(raw string) (*Version, error) {
+	matches := versionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+
+	if matches == nil {
+		return nil, fmt.Errorf("Invalid version %q", raw)
+	}
+
+	v := &Version{Raw: raw}
+	parts := []*int{&v.Major, &v.Minor, &v.Patch, &v.Build}
+
+	for i, part := range parts {
+		if matches[i+1] != "" {
+			*part, _ = strconv.Atoi(matches[i+1])
+		}
+	}
+
+	suffix := strings.TrimLeft(matches[5], "-.")
+
+	if strings.Contains(suffix, "enterprise") {
+		v.Enterprise = true
+		suffix = ""
+	}
+
+	v.Enterprise = v.Enterprise || matches[4] != ""
+	v.Prerelease = suffix
+
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 when the version is lower, equal or greater than the other. Pre-releases
+// are lower than the final release.
+func (v *Version) Compare(other *Version) int {
+	a := []int{v.Major, v.Minor, v.Patch, v.Build}
+	b := []int{other.Major, other.Minor, other.Patch, other.Build}
+
+	for i := range a {
+		if a[i] < b[i] {
+			return -1
+		}
+
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+
+	switch {
+	case v.Prerelease == other.Prerelease:
+		return 0
+	case v.Prerelease == "":
+		return 1
+	case other.Prerelease == "":
+		return -1
+	}
+
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// comparePrerelease compares two pre-releases by label, then by number, so rc10 is greater than rc3.
+func comparePrerelease(a, b string) int {
+	x, y := prereleasePattern.FindStringSubmatch(a), prereleasePattern.FindStringSubmatch(b)
+
+	if x[1] != y[1] {
+		return strings.Compare(x[1], y[1])
+	}
+
+	m, _ := strconv.Atoi(x[2])
+	n, _ := strconv.Atoi(y[2])
+
+	switch {
+	case m < n:
+		return -1
+	case m > n:
+		return 1
+	}
+
+	return strings.Compare(x[3], y[3])
+}
+
+// Supports reports whether the version supports the capability.
+func (v *Version) Supports(capability Capability) bool {
+	minimum, ok := capabilities[capability]
+
+	return ok && v.Compare(minimum) >= 0
+}
+
+// String returns the version in major.minor.patch format.
+func (v *Version) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+
+	if v.Build != 0 {
+		s = fmt.Sprintf("%s.%d", s, v.Build)
+	}
+
+	if v.Prerelease != "" {
+		s = fmt.Sprintf("%s-%s", s, v.Prerelease)
+	}
+
+	return s
+}
+
+// ServerVersion retrieves the Kong server version. Versions are recorded by admin node from the node
+// information responses, e.g. of EndpointPool.Check, and the oldest one is returned so a capability is only
+// used when every known node supports it. The node information is retrieved when no version is known yet.
+func (k *Kongo) ServerVersion(ctx context.Context) (*Version, error) {
+	if v := k.oldestVersion(); v != nil {
+		return v, nil
+	}
+
+	info, _, err := k.Node.InfoWithContext(withoutCallInterceptors(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := ParseVersion(info.Version)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if oldest := k.oldestVersion(); oldest != nil {
+		return oldest, nil
+	}
+
+	return v, nil
+}
+
+// recordVersion records the version of the admin node that served the response.
+func (k *Kongo) recordVersion(res *Response, raw string) {
+	v, err := ParseVersion(raw)
+
+	if err != nil || res == nil {
+		return
+	}
+
+	k.versionMu.Lock()
+	defer k.versionMu.Unlock()
+
+	if k.versions == nil {
+		k.versions = map[string]*Version{}
+	}
+
+	node := ""
+
+	if endpoint := ResponseEndpoint(res.Response); endpoint != nil {
+		node = endpoint.String()
+	}
+
+	k.versions[node] = v
+}
+
+// oldestVersion returns the oldest recorded version, nil when none was recorded.
+func (k *Kongo) oldestVersion() *Version {
+	k.versionMu.Lock()
+	defer k.versionMu.Unlock()
+
+	var oldest *Version
+
+	for _, v := range k.versions {
+		if oldest == nil || v.Compare(oldest) < 0 {
+			oldest = v
+		}
+	}
+
+	return oldest
+}
+
+// Supports reports whether the Kong server supports the capability.
+func (k *Kongo) Supports(ctx context.Context, capability Capability) (bool, error) {
+	v, err := k.ServerVersion(ctx)
+
+	if err != nil {
+		return false, err
+	}
+
+	return v.Supports(capability), nil
+}
+
+// require returns ErrUnsupportedByServer when the Kong server lacks any of the capabilities. When the server
+// version can't be retrieved the check is skipped and the server has the last word.
+func (k *Kongo) require(ctx context.Context, capabilities ...Capability) error {
+	for _, capability := range capabilities {
+		supported, err := k.Supports(ctx, capability)
+
+		if err != nil {
+			return nil
+		}
+
+		if !supported {
+			return fmt.Errorf("%w: %s requires Kong %s or later", ErrUnsupportedByServer, capability, minimumVersion(capability))
+		}
+	}
+
+	return nil
+}
+
+// minimumVersion returns the minimum version of the capability.
+func minimumVersion(capability Capability) string {
+	if minimum, ok := capabilities[capability]; ok {
+		return minimum.String()
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,244 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type VersionTestSuite struct {
+	BaseTestSuite
+}
+
+func (s *VersionTestSuite) serverVersion(version string) *int {
+	calls := 0
+
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != nodeInfoResourcePath {
+			s.Failf("unexpected request", "%s %s", r.Method, r.URL.Path)
+
+			return
+		}
+
+		calls++
+
+		fmt.Fprintf(w, `{"version": %q}`, version)
+	})
+
+	return &calls
+}
+
+func (s *VersionTestSuite) TestParseVersion() {
+	cases := map[string]*Version{
+		"0.13.0":                     {Major: 0, Minor: 13, Patch: 0},
+		"1.0.0rc3":                   {Major: 1, Prerelease: "rc3"},
+		"2.8":                        {Major: 2, Minor: 8},
+		"3.4.0-beta.1":               {Major: 3, Minor: 4, Prerelease: "beta.1"},
+		"2.8.1.0-enterprise-edition": {Major: 2, Minor: 8, Patch: 1, Enterprise: true},
+		"3.4.3.2":                    {Major: 3, Minor: 4, Patch: 3, Build: 2, Enterprise: true},
+	}
+
+	for raw, expected := range cases {
+		v, err := ParseVersion(raw)
+		expected.Raw = raw
+
+		s.assert.Nil(err, raw)
+		s.assert.Equal(expected, v, raw)
+	}
+
+	_, err := ParseVersion("next")
+
+	s.assert.EqualError(err, `Invalid version "next"`)
+}
+
+func (s *VersionTestSuite) TestCompare() {
+	parse := func(raw string) *Version {
+		v, _ := ParseVersion(raw)
+
+		return v
+	}
+
+	s.assert.Equal(0, parse("1.1.0").Compare(parse("1.1")))
+	s.assert.Equal(-1, parse("0.13.0").Compare(parse("1.0.0")))
+	s.assert.Equal(1, parse("2.8.1.1").Compare(parse("2.8.1.0-enterprise-edition")))
+	s.assert.Equal(-1, parse("1.0.0rc3").Compare(parse("1.0.0")))
+	s.assert.Equal(1, parse("1.0.0").Compare(parse("1.0.0rc3")))
+	s.assert.Equal(-1, parse("1.0.0rc2").Compare(parse("1.0.0rc3")))
+	s.assert.Equal(1, parse("1.0.0rc3").Compare(parse("1.0.0rc2")))
+	s.assert.Equal(1, parse("1.0.0rc10").Compare(parse("1.0.0rc3")))
+	s.assert.Equal(-1, parse("3.4.0-beta.2").Compare(parse("3.4.0-beta.10")))
+	s.assert.Equal(-1, parse("1.0.0beta1").Compare(parse("1.0.0rc1")))
+	s.assert.Equal("2.8.1-rc1", parse("2.8.1rc1").String())
+	s.assert.Equal("2.8.1.2", parse("2.8.1.2").String())
+}
+
+func (s *VersionTestSuite) TestSupports() {
+	v, _ := ParseVersion("1.1.0")
+
+	s.assert.True(v.Supports(CapabilityTags))
+	s.assert.False(v.Supports(CapabilityPathHandling))
+	s.assert.False(v.Supports(CapabilityReadiness))
+	s.assert.False(v.Supports(Capability("unknown")))
+}
+
+func (s *VersionTestSuite) TestServerVersionIsCached() {
+	calls := s.serverVersion("2.8.1.0-enterprise-edition")
+
+	v, err := s.client.ServerVersion(context.Background())
+
+	s.assert.Nil(err)
+	s.assert.True(v.Enterprise)
+
+	supported, err := s.client.Supports(context.Background(), CapabilityPathHandling)
+
+	s.assert.Nil(err)
+	s.assert.True(supported)
+	s.assert.Equal(1, *calls)
+}
+
+func (s *VersionTestSuite) TestServerVersionErrors() {
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "unknown"}`)
+	})
+
+	_, err := s.client.ServerVersion(context.Background())
+
+	s.assert.EqualError(err, `Invalid version "unknown"`)
+
+	s.server.Close()
+
+	_, err = s.client.Supports(context.Background(), CapabilityTags)
+
+	s.assert.Error(err)
+}
+
+func (s *VersionTestSuite) TestUnsupportedRouteFailsEarly() {
+	s.serverVersion("0.13.0")
+
+	_, res, err := s.client.Routes.Create(&Route{Paths: []string{"/api"}, PathHandling: "v1"})
+
+	s.assert.Nil(res)
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+	s.assert.EqualError(err, "Unsupported by server: path_handling requires Kong 2.0.0 or later")
+
+	_, _, err = s.client.Routes.Update("foo", &Route{Tags: []string{"team-a"}})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+
+	_, _, err = s.client.Services.Create(&Service{Name: "foo", Tags: []string{"team-a"}})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+
+	_, _, err = s.client.Services.UpdateByURL("foo", &Service{URL: "http://example.com", Tags: []string{"team-a"}})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+
+	_, _, err = s.client.Customers.Create(&Customer{Username: "foo", Tags: []string{"team-a"}})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+
+	_, _, err = s.client.Customers.Update("foo", &Customer{Tags: []string{"team-a"}})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+}
+
+func (s *VersionTestSuite) TestSupportedRoute() {
+	s.serverVersion("2.0.0")
+
+	s.mux.HandleFunc(routesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "path_handling": "v1", "tags": ["team-a"]}`)
+	})
+
+	route, _, err := s.client.Routes.Create(&Route{PathHandling: "v1", Tags: []string{"team-a"}})
+
+	s.assert.Nil(err)
+	s.assert.Equal("v1", route.PathHandling)
+	s.assert.Equal([]string{"team-a"}, route.Tags)
+}
+
+func (s *VersionTestSuite) TestCheckIsSkippedWhenVersionIsUnknown() {
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	s.mux.HandleFunc(routesResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1", "tags": ["team-a"]}`)
+	})
+
+	route, _, err := s.client.Routes.Create(&Route{Tags: []string{"team-a"}})
+
+	s.assert.Nil(err)
+	s.assert.Equal("1", route.Id)
+}
+
+func (s *VersionTestSuite) TestOldestNodeVersionIsUsed() {
+	s.serverVersion("2.0.0")
+
+	s.mux.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	old := http.NewServeMux()
+
+	old.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "1.1.0"}`)
+	})
+
+	old.HandleFunc(nodeStatusResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"database": {"reachable": true}}`)
+	})
+
+	oldServer := httptest.NewServer(old)
+	defer oldServer.Close()
+
+	pool, _ := NewEndpointPool(s.server.URL, oldServer.URL)
+	s.client.Use(pool.Interceptor())
+
+	pool.Check(context.Background(), s.client)
+
+	v, err := s.client.ServerVersion(context.Background())
+
+	s.assert.Nil(err)
+	s.assert.Equal("1.1.0", v.String())
+
+	_, _, err = s.client.Routes.Create(&Route{PathHandling: "v1"})
+
+	s.assert.True(errors.Is(err, ErrUnsupportedByServer))
+}
+
+func (s *VersionTestSuite) TestKong3ServerVersion() {
+	s.mux.HandleFunc(nodeInfoResourcePath, func(w http.ResponseWriter, r *http.Request) {
+		file, _ := s.LoadFixture("fixtures/node_info_payload_3x.json")
+
+		io.Copy(w, file)
+
+		defer file.Close()
+	})
+
+	supported, err := s.client.Supports(context.Background(), CapabilityReadiness)
+
+	s.assert.Nil(err)
+	s.assert.True(supported)
+}
+
+func (s *VersionTestSuite) TestServerVersionDropsCallInterceptors() {
+	s.serverVersion("3.4.0")
+
+	ctx := ContextWithInterceptors(context.Background(), func(req *http.Request, value interface{}, next Handler) (*http.Response, error) {
+		return nil, errors.New("call interceptor")
+	})
+
+	v, err := s.client.ServerVersion(ctx)
+
+	s.assert.Nil(err)
+	s.assert.Equal("3.4.0", v.String())
+}
+
+func TestVersionTestSuite(t *testing.T) {
+	suite.Run(t, new(VersionTestSuite))
+}
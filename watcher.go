@@ -0,0 +1,227 @@
+package kongo
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// StatusSampled is emitted on every successful poll, carrying the derived rates.
+	StatusSampled StatusEventType = iota
+
+	// StatusFailed is emitted when the status request fails.
+	StatusFailed
+
+	// DatabaseReachable is emitted when the database becomes reachable.
+	DatabaseReachable
+
+	// DatabaseUnreachable is emitted when the database becomes unreachable.
+	DatabaseUnreachable
+
+	// ConnectionsAboveThreshold is emitted when the active connections reach the threshold.
+	ConnectionsAboveThreshold
+
+	// ConnectionsBelowThreshold is emitted when the active connections go back under the threshold.
+	ConnectionsBelowThreshold
+)
+
+type (
+	// StatusEventType it's the kind of a status event.
+	StatusEventType int
+
+	// StatusEvent it's an event emitted by the status watcher.
+	StatusEvent struct {
+		// The event type.
+		Type StatusEventType
+
+		// The time of the poll that originated the event.
+		Time time.Time
+
+		// The polled status, nil when the request failed.
+		Status *NodeStatus
+
+		// The rates derived from the previous poll, nil on the first poll.
+		Rates *StatusRates
+
+		// The error returned by the status request.
+		Error error
+	}
+
+	// StatusRates it's the rates derived from two consecutive status polls.
+	StatusRates struct {
+		// Requests handled per second.
+		RequestsPerSecond float64
+
+		// Connections accepted per second.
+		ConnectionsAcceptedPerSecond float64
+
+		// Connections accepted but not handled since the previous poll.
+		ConnectionsDropped int
+
+		// Connections accepted but not handled per second.
+		ConnectionsDroppedPerSecond float64
+	}
+
+	// StatusWatcherOptions stores the options you can set for watching the node status.
+	StatusWatcherOptions struct {
+		// Interval between the polls. Defaults to 10 seconds.
+		Interval time.Duration
+
+		// Number of active connections that fires the threshold events, zero disables them.
+		ConnectionsActiveThreshold int
+	}
+
+	// StatusWatcher polls the node status and emits events when it changes.
+	StatusWatcher struct {
+		// Node api service polled by the watcher.
+		node Node
+
+		// Status watcher options.
+		options StatusWatcherOptions
+
+		// Clock used to timestamp the polls.
+		now func() time.Time
+	}
+
+	// statusSample it's a successful poll.
+	statusSample struct {
+		time   time.Time
+		status *NodeStatus
+	}
+)
+
+// NewStatusWatcher returns a status watcher of the node.
+func NewStatusWatcher(node Node, options *StatusWatcherOptions) *StatusWatcher {
+	opts := StatusWatcherOptions{}
+
+	if options != nil {
+		opts = *options
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = 10 * time.Second
+	}
+
+	return &StatusWatcher{node: node, options: opts, now: time.Now}
+}
+
+// Watch polls the node status until the context is done and emits the events on the returned channel,
+// which is closed when the watcher stops.
+func (w *StatusWatcher) Watch(ctx context.Context) <-chan *StatusEvent {
+	events := make(chan *StatusEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.options.Interval)
+		defer ticker.Stop()
+
+		var (
+			previous  *statusSample
+			reachable *bool
+			above     bool
+		)
+
+		for {
+			status, _, err := w.node.StatusWithContext(ctx)
+			now := w.now()
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			batch := []*StatusEvent{}
+
+			if err != nil {
+				batch = append(batch, &StatusEvent{Type: StatusFailed, Time: now, Error: err})
+			} else {
+				sample := &statusSample{time: now, status: status}
+				rates := deriveRates(previous, sample)
+
+				batch = append(batch, &StatusEvent{Type: StatusSampled, Time: now, Status: status, Rates: rates})
+
+				isReachable := status.Database != nil && status.Database.Reachable
+
+				if reachable == nil && !isReachable || reachable != nil && *reachable != isReachable {
+					eventType := DatabaseUnreachable
+
+					if isReachable {
+						eventType = DatabaseReachable
+					}
+
+					batch = append(batch, &StatusEvent{Type: eventType, Time: now, Status: status, Rates: rates})
+				}
+
+				reachable = &isReachable
+
+				if threshold := w.options.ConnectionsActiveThreshold; threshold > 0 && status.Server != nil {
+					isAbove := status.Server.ConnectionsActive >= threshold
+
+					if isAbove != above {
+						eventType := ConnectionsBelowThreshold
+
+						if isAbove {
+							eventType = ConnectionsAboveThreshold
+						}
+
+						batch = append(batch, &StatusEvent{Type: eventType, Time: now, Status: status, Rates: rates})
+					}
+
+					above = isAbove
+				}
+
+				previous = sample
+			}
+
+			for _, event := range batch {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// deriveRates computes the rates between two polls, counters reset by a node restart are ignored.
+func deriveRates(previous, current *statusSample) *StatusRates {
+	if previous == nil || previous.status.Server == nil || current.status.Server == nil {
+		return nil
+	}
+
+	elapsed := current.time.Sub(previous.time).Seconds()
+
+	if elapsed <= 0 {
+		return nil
+	}
+
+	prev, cur := previous.status.Server, current.status.Server
+	rates := &StatusRates{}
+
+	if requests := cur.TotalRequests - prev.TotalRequests; requests > 0 {
+		rates.RequestsPerSecond = float64(requests) / elapsed
+	}
+
+	if accepted := cur.ConnectionsAccepted - prev.ConnectionsAccepted; accepted > 0 {
+		rates.ConnectionsAcceptedPerSecond = float64(accepted) / elapsed
+	}
+
+	prevDropped := prev.ConnectionsAccepted - prev.ConnectionsHandled
+	curDropped := cur.ConnectionsAccepted - cur.ConnectionsHandled
+
+	if dropped := curDropped - prevDropped; dropped > 0 {
+		rates.ConnectionsDropped = dropped
+		rates.ConnectionsDroppedPerSecond = float64(dropped) / elapsed
+	}
+
+	return rates
+}
@@ -0,0 +1,150 @@
+package kongo
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type (
+	StatusWatcherTestSuite struct {
+		BaseTestSuite
+	}
+
+	MockNode struct {
+		Node
+
+		statuses []*NodeStatus
+		polls    int
+	}
+)
+
+func (m *MockNode) StatusWithContext(ctx context.Context) (*NodeStatus, *Response, error) {
+	status := m.statuses[m.polls%len(m.statuses)]
+	m.polls++
+
+	if status == nil {
+		return nil, nil, errors.New("connection refused")
+	}
+
+	return status, nil, nil
+}
+
+func (s *StatusWatcherTestSuite) status(reachable bool, active, accepted, handled, requests int) *NodeStatus {
+	return &NodeStatus{
+		Database: &NodeStatusDatabase{Reachable: reachable},
+		Server: &NodeStatusServer{
+			ConnectionsActive:   active,
+			ConnectionsAccepted: accepted,
+			ConnectionsHandled:  handled,
+			TotalRequests:       requests,
+		},
+	}
+}
+
+func (s *StatusWatcherTestSuite) TestDefaultOptions() {
+	watcher := NewStatusWatcher(s.client.Node, nil)
+
+	s.assert.Equal(10*time.Second, watcher.options.Interval)
+	s.assert.Zero(watcher.options.ConnectionsActiveThreshold)
+}
+
+func (s *StatusWatcherTestSuite) TestWatch() {
+	node := &MockNode{statuses: []*NodeStatus{
+		s.status(true, 1, 100, 100, 1000),
+		s.status(true, 5, 120, 118, 1500),
+		nil,
+		s.status(false, 2, 130, 128, 1600),
+	}}
+
+	clock := time.Date(2018, 4, 4, 0, 0, 0, 0, time.UTC)
+	watcher := NewStatusWatcher(node, &StatusWatcherOptions{Interval: time.Millisecond, ConnectionsActiveThreshold: 5})
+	watcher.now = func() time.Time {
+		clock = clock.Add(10 * time.Second)
+
+		return clock
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	defer cancel()
+
+	events := watcher.Watch(ctx)
+
+	types := []StatusEventType{}
+	received := []*StatusEvent{}
+
+	for event := range events {
+		types = append(types, event.Type)
+		received = append(received, event)
+
+		if len(received) == 7 {
+			cancel()
+		}
+	}
+
+	s.assert.Equal([]StatusEventType{
+		StatusSampled,
+		StatusSampled,
+		ConnectionsAboveThreshold,
+		StatusFailed,
+		StatusSampled,
+		DatabaseUnreachable,
+		ConnectionsBelowThreshold,
+	}, types)
+
+	s.assert.Nil(received[0].Rates)
+	s.assert.Equal(&StatusRates{
+		RequestsPerSecond:            50,
+		ConnectionsAcceptedPerSecond: 2,
+		ConnectionsDropped:           2,
+		ConnectionsDroppedPerSecond:  0.2,
+	}, received[1].Rates)
+	s.assert.EqualError(received[3].Error, "connection refused")
+	s.assert.InDelta(5, received[4].Rates.RequestsPerSecond, 0.001)
+	s.assert.Zero(received[4].Rates.ConnectionsDropped)
+}
+
+func (s *StatusWatcherTestSuite) TestWatchStartsUnreachable() {
+	node := &MockNode{statuses: []*NodeStatus{
+		s.status(false, 0, 0, 0, 0),
+		s.status(true, 0, 0, 0, 0),
+	}}
+
+	watcher := NewStatusWatcher(node, &StatusWatcherOptions{Interval: time.Millisecond})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	defer cancel()
+
+	types := []StatusEventType{}
+
+	for event := range watcher.Watch(ctx) {
+		types = append(types, event.Type)
+
+		if len(types) == 4 {
+			cancel()
+		}
+	}
+
+	s.assert.Equal([]StatusEventType{StatusSampled, DatabaseUnreachable, StatusSampled, DatabaseReachable}, types)
+}
+
+func (s *StatusWatcherTestSuite) TestRatesIgnoreCounterReset() {
+	now := time.Now()
+	rates := deriveRates(
+		&statusSample{time: now, status: s.status(true, 0, 500, 500, 5000)},
+		&statusSample{time: now.Add(time.Second), status: s.status(true, 0, 10, 10, 20)},
+	)
+
+	s.assert.Equal(&StatusRates{}, rates)
+	s.assert.Nil(deriveRates(
+		&statusSample{time: now, status: s.status(true, 0, 0, 0, 0)},
+		&statusSample{time: now, status: s.status(true, 0, 0, 0, 0)},
+	))
+}
+
+func TestStatusWatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusWatcherTestSuite))
+}